@@ -0,0 +1,80 @@
+// Package apierror defines the errors returned to clients. Each carries a code, sent in the
+// error's extensions, so clients can tell failures apart without matching on messages.
+package apierror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type Code string
+
+const (
+	NotFound        Code = "NOT_FOUND"
+	InvalidArgument Code = "INVALID_ARGUMENT"
+	LimitExceeded   Code = "LIMIT_EXCEEDED"
+	Unimplemented   Code = "UNIMPLEMENTED"
+	Internal        Code = "INTERNAL"
+	Timeout         Code = "TIMEOUT"
+)
+
+type Error struct {
+	Code    Code
+	Message string
+	// Extra extensions sent alongside the code
+	Details map[string]interface{}
+}
+
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+func Errorf(code Code, format string, args ...interface{}) *Error {
+	return &Error{Code: code, Message: fmt.Sprintf(format, args...)}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Extensions is read by gqlgen's error presenter
+func (e *Error) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{"code": string(e.Code)}
+	for k, v := range e.Details {
+		extensions[k] = v
+	}
+	return extensions
+}
+
+// Presenter sends typed errors as they are. Anything else is an internal failure, usually from
+// the database, which is logged and replaced so that SQL and connection details never reach
+// the client.
+func Presenter(ctx context.Context, err error) *gqlerror.Error {
+	var gqlErr *gqlerror.Error
+	if errors.As(err, &gqlErr) {
+		return graphql.DefaultErrorPresenter(ctx, gqlErr)
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		attrs := []any{"error", err}
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			attrs = append(attrs, "path", fc.Path().String())
+		}
+		slog.ErrorContext(ctx, "resolver failed", attrs...)
+		apiErr = New(Internal, "Internal error")
+	}
+	return graphql.DefaultErrorPresenter(ctx, apiErr)
+}
+
+// Recover logs a resolver panic and reports it as an internal error
+func Recover(ctx context.Context, recovered interface{}) error {
+	slog.ErrorContext(ctx, "resolver panicked", "panic", fmt.Sprint(recovered), "stack", string(debug.Stack()))
+	return New(Internal, "Internal error")
+}
@@ -0,0 +1,96 @@
+// Package clientip works out the address a request came from. X-Forwarded-For is only read
+// when the connection comes from one of the configured proxies, since anyone else can send
+// whatever they like in it.
+package clientip
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+type contextKey struct{}
+
+// Proxies are the load balancers trusted to append the address they received a connection
+// from to X-Forwarded-For
+type Proxies []netip.Prefix
+
+// ParseProxies reads a list of addresses and CIDR ranges
+func ParseProxies(entries []string) (Proxies, error) {
+	proxies := make(Proxies, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.Contains(entry, "/") {
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return nil, fmt.Errorf("trusted proxy %q: %v", entry, err)
+			}
+			proxies = append(proxies, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q: %v", entry, err)
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return proxies, nil
+}
+
+func (p Proxies) trusted(address string) bool {
+	addr, err := netip.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range p {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Resolve returns the client's address. Forwarded addresses are walked from the last, which
+// the nearest proxy added, past any other trusted proxies. The first one that isn't a proxy is
+// the client, and anything before it could have been made up by the client.
+func (p Proxies) Resolve(req *http.Request) string {
+	client, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		client = req.RemoteAddr
+	}
+	if !p.trusted(client) {
+		return client
+	}
+
+	hops := strings.Split(strings.Join(req.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if hop == "" {
+			continue
+		}
+		client = hop
+		if !p.trusted(hop) {
+			break
+		}
+	}
+	return client
+}
+
+// Middleware resolves the client's address once so that FromRequest can be used further in
+func Middleware(proxies Proxies, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		next.ServeHTTP(w, req.WithContext(context.WithValue(req.Context(), contextKey{}, proxies.Resolve(req))))
+	})
+}
+
+// FromRequest returns the address resolved by Middleware, or the connection's address when the
+// request didn't pass through it
+func FromRequest(req *http.Request) string {
+	if client, ok := req.Context().Value(contextKey{}).(string); ok {
+		return client
+	}
+	return Proxies(nil).Resolve(req)
+}
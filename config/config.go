@@ -0,0 +1,547 @@
+// Package config loads the server settings. Values are layered so that each source overrides
+// the one before it: built in defaults, then a YAML or TOML file, then environment variables
+// and finally command line flags.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/owenjacob/hubblegraphql/clientip"
+	"gopkg.in/yaml.v2"
+)
+
+type Config struct {
+	ListenAddr string `yaml:"listenAddr" toml:"listenAddr"`
+	// How long in-flight queries and subscriptions get to finish once a shutdown signal arrives
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout" toml:"shutdownTimeout"`
+	// Addresses or CIDR ranges of the load balancers in front of the server. X-Forwarded-For
+	// is ignored on connections from anywhere else.
+	TrustedProxies   []string         `yaml:"trustedProxies" toml:"trustedProxies"`
+	Timeouts         Timeouts         `yaml:"timeouts" toml:"timeouts"`
+	DB               DB               `yaml:"db" toml:"db"`
+	Health           Health           `yaml:"health" toml:"health"`
+	CORS             CORS             `yaml:"cors" toml:"cors"`
+	HTTPCache        HTTPCache        `yaml:"httpCache" toml:"httpCache"`
+	EntityCache      EntityCache      `yaml:"entityCache" toml:"entityCache"`
+	Log              Log              `yaml:"log" toml:"log"`
+	Tracing          Tracing          `yaml:"tracing" toml:"tracing"`
+	Metrics          Metrics          `yaml:"metrics" toml:"metrics"`
+	Limits           Limits           `yaml:"limits" toml:"limits"`
+	RateLimit        RateLimit        `yaml:"rateLimit" toml:"rateLimit"`
+	PersistedQueries PersistedQueries `yaml:"persistedQueries" toml:"persistedQueries"`
+}
+
+// Either a full DSN or its parts. When DSN is set the parts are ignored.
+type DB struct {
+	DSN          string `yaml:"dsn" toml:"dsn"`
+	Host         string `yaml:"host" toml:"host"`
+	Port         int    `yaml:"port" toml:"port"`
+	User         string `yaml:"user" toml:"user"`
+	Password     string `yaml:"password" toml:"password"`
+	Name         string `yaml:"name" toml:"name"`
+	SSLMode      string `yaml:"sslMode" toml:"sslMode"`
+	SSLCert      string `yaml:"sslCert" toml:"sslCert"`
+	SSLKey       string `yaml:"sslKey" toml:"sslKey"`
+	SSLRootCert  string `yaml:"sslRootCert" toml:"sslRootCert"`
+	MaxOpenConns int    `yaml:"maxOpenConns" toml:"maxOpenConns"`
+	MinConns     int    `yaml:"minConns" toml:"minConns"`
+	// Connections are replaced after this long, so a failover or new DNS answer is picked up
+	ConnMaxLifetime time.Duration `yaml:"connMaxLifetime" toml:"connMaxLifetime"`
+	// Server side limit on every statement, including those not tied to a request. Zero disables it.
+	StatementTimeout time.Duration `yaml:"statementTimeout" toml:"statementTimeout"`
+	// DSNs of read replicas that history queries are spread over, with the same pool settings
+	Replicas []string `yaml:"replicas" toml:"replicas"`
+	// A replica whose latest ledger closed longer than this before the primary's takes no reads
+	MaxReplicaLag        time.Duration `yaml:"maxReplicaLag" toml:"maxReplicaLag"`
+	ReplicaCheckInterval time.Duration `yaml:"replicaCheckInterval" toml:"replicaCheckInterval"`
+}
+
+// Deadline for each GraphQL operation, after which its statements are cancelled
+type Timeouts struct {
+	Request time.Duration `yaml:"request" toml:"request"`
+	// Keyed by root field, e.g. strictSendPaths
+	Overrides map[string]time.Duration `yaml:"overrides" toml:"overrides"`
+	// Deadline for a whole CSV export, including writing it to the client
+	Export time.Duration `yaml:"export" toml:"export"`
+}
+
+type Health struct {
+	// Readiness fails once the latest ingested ledger closed longer ago than this
+	MaxIngestionLag time.Duration `yaml:"maxIngestionLag" toml:"maxIngestionLag"`
+}
+
+type CORS struct {
+	AllowedOrigins []string `yaml:"allowedOrigins" toml:"allowedOrigins"`
+}
+
+// Caching of GET queries that only read finalized ledgers, transactions and operations
+type HTTPCache struct {
+	MaxAge time.Duration `yaml:"maxAge" toml:"maxAge"`
+}
+
+// In-process cache of ledgers, transactions and operations read from the database
+type EntityCache struct {
+	// Memory the cached rows may hold, zero turns the cache off
+	MaxMemoryMB int `yaml:"maxMemoryMB" toml:"maxMemoryMB"`
+}
+
+type Log struct {
+	Level string `yaml:"level" toml:"level"`
+	// Fraction of SQL statements logged when the level is debug
+	SQLSampleRate float64 `yaml:"sqlSampleRate" toml:"sqlSampleRate"`
+}
+
+// OpenTelemetry export, disabled unless Enabled is set
+type Tracing struct {
+	Enabled     bool    `yaml:"enabled" toml:"enabled"`
+	Endpoint    string  `yaml:"endpoint" toml:"endpoint"`
+	Insecure    bool    `yaml:"insecure" toml:"insecure"`
+	SampleRatio float64 `yaml:"sampleRatio" toml:"sampleRatio"`
+	ServiceName string  `yaml:"serviceName" toml:"serviceName"`
+}
+
+// Prometheus metrics are served on their own address, kept off the public API
+type Metrics struct {
+	ListenAddr string `yaml:"listenAddr" toml:"listenAddr"`
+	// Operation names reported as they are, besides those in the operation manifest. Anything
+	// else clients name their operations is reported as "other".
+	OperationNames []string `yaml:"operationNames" toml:"operationNames"`
+}
+
+// Bounds on how much data a single query may request
+type Limits struct {
+	MaxSearchLimit     int `yaml:"maxSearchLimit" toml:"maxSearchLimit"`
+	MaxTxnsLimit       int `yaml:"maxTxnsLimit" toml:"maxTxnsLimit"`
+	MaxOpsLimit        int `yaml:"maxOpsLimit" toml:"maxOpsLimit"`
+	MaxPathHops        int `yaml:"maxPathHops" toml:"maxPathHops"`
+	MaxOrderBookOffers int `yaml:"maxOrderBookOffers" toml:"maxOrderBookOffers"`
+	MaxPathExpansions  int `yaml:"maxPathExpansions" toml:"maxPathExpansions"`
+	MaxFeeStatsLedgers int `yaml:"maxFeeStatsLedgers" toml:"maxFeeStatsLedgers"`
+	MaxExportLedgers   int `yaml:"maxExportLedgers" toml:"maxExportLedgers"`
+	MaxQueryDepth      int `yaml:"maxQueryDepth" toml:"maxQueryDepth"`
+	MaxQueryComplexity int `yaml:"maxQueryComplexity" toml:"maxQueryComplexity"`
+}
+
+// API keys come from at most one of KeysFile and KeysTable. Without either every client
+// is anonymous.
+type RateLimit struct {
+	Enabled   bool   `yaml:"enabled" toml:"enabled"`
+	KeysFile  string `yaml:"keysFile" toml:"keysFile"`
+	KeysTable string `yaml:"keysTable" toml:"keysTable"`
+	// Allowance shared by each address that sends no key, zero requests turns anonymous access off
+	Anonymous Tier `yaml:"anonymous" toml:"anonymous"`
+	// Allowance for keys that don't set their own
+	Keys Tier `yaml:"keys" toml:"keys"`
+}
+
+type Tier struct {
+	RequestsPerMinute   int `yaml:"requestsPerMinute" toml:"requestsPerMinute"`
+	ComplexityPerMinute int `yaml:"complexityPerMinute" toml:"complexityPerMinute"`
+}
+
+// Automatic persisted queries, cached in memory or in a Postgres table, and an optional
+// manifest of registered operations
+type PersistedQueries struct {
+	Cache     string `yaml:"cache" toml:"cache"`
+	CacheSize int    `yaml:"cacheSize" toml:"cacheSize"`
+	Table     string `yaml:"table" toml:"table"`
+	// Registrations stop being written to the table once it holds this many documents
+	TableMaxRows int `yaml:"tableMaxRows" toml:"tableMaxRows"`
+	// Directory of .graphql files. In strict mode nothing else can run.
+	ManifestDir string `yaml:"manifestDir" toml:"manifestDir"`
+	Strict      bool   `yaml:"strict" toml:"strict"`
+}
+
+var sslModes = []string{"disable", "allow", "prefer", "require", "verify-ca", "verify-full"}
+
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+var persistedQueryCaches = []string{"memory", "postgres"}
+
+// Table names are written into queries as is, so it has to be a plain identifier
+var tableName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+func Default() *Config {
+	return &Config{
+		ListenAddr:      ":3000",
+		ShutdownTimeout: 30 * time.Second,
+		Timeouts: Timeouts{
+			Request: 10 * time.Second,
+			Export:  2 * time.Minute,
+		},
+		DB: DB{
+			Port:                 5432,
+			SSLMode:              "disable",
+			MinConns:             2,
+			ConnMaxLifetime:      30 * time.Minute,
+			StatementTimeout:     30 * time.Second,
+			MaxReplicaLag:        30 * time.Second,
+			ReplicaCheckInterval: 10 * time.Second,
+		},
+		Health: Health{
+			MaxIngestionLag: 2 * time.Minute,
+		},
+		CORS: CORS{
+			AllowedOrigins: []string{"*"},
+		},
+		HTTPCache: HTTPCache{
+			MaxAge: 24 * time.Hour,
+		},
+		EntityCache: EntityCache{
+			MaxMemoryMB: 64,
+		},
+		Log: Log{
+			Level:         "info",
+			SQLSampleRate: 0.01,
+		},
+		Metrics: Metrics{
+			ListenAddr: ":9100",
+		},
+		Tracing: Tracing{
+			Endpoint:    "localhost:4318",
+			SampleRatio: 1,
+			ServiceName: "hubblegraphql",
+		},
+		Limits: Limits{
+			MaxSearchLimit:     500,
+			MaxTxnsLimit:       105,
+			MaxOpsLimit:        100,
+			MaxPathHops:        4,
+			MaxOrderBookOffers: 5000,
+			MaxPathExpansions:  2000,
+			MaxFeeStatsLedgers: 100,
+			MaxExportLedgers:   1000,
+			MaxQueryDepth:      10,
+			MaxQueryComplexity: 100000,
+		},
+		RateLimit: RateLimit{
+			Enabled: true,
+			Anonymous: Tier{
+				RequestsPerMinute:   60,
+				ComplexityPerMinute: 300000,
+			},
+			Keys: Tier{
+				RequestsPerMinute:   600,
+				ComplexityPerMinute: 3000000,
+			},
+		},
+		PersistedQueries: PersistedQueries{
+			Cache:        "memory",
+			CacheSize:    1000,
+			Table:        "hubble_persisted_queries",
+			TableMaxRows: 10000,
+		},
+	}
+}
+
+// Load builds the configuration from args, which should not include the program name.
+// The config file is taken from the -config flag or the HUBBLE_CONFIG environment variable.
+func Load(args []string) (*Config, error) {
+	c := Default()
+	bindings := c.settings()
+
+	fs := flag.NewFlagSet("hubblegraphql", flag.ContinueOnError)
+	configFile := fs.String("config", os.Getenv("HUBBLE_CONFIG"), "path to a YAML or TOML config file (env HUBBLE_CONFIG)")
+	raw := map[string]*string{}
+	for _, s := range bindings {
+		raw[s.flag] = fs.String(s.flag, "", s.usage+" (env "+s.env+")")
+	}
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *configFile != "" {
+		if err := c.loadFile(*configFile); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, s := range bindings {
+		if value, ok := os.LookupEnv(s.env); ok {
+			if err := s.set(value); err != nil {
+				return nil, fmt.Errorf("invalid %s: %v", s.env, err)
+			}
+		}
+	}
+
+	var flagErr error
+	fs.Visit(func(f *flag.Flag) {
+		for _, s := range bindings {
+			if s.flag == f.Name && flagErr == nil {
+				if err := s.set(*raw[f.Name]); err != nil {
+					flagErr = fmt.Errorf("invalid -%s: %v", f.Name, err)
+				}
+			}
+		}
+	})
+	if flagErr != nil {
+		return nil, flagErr
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Config) loadFile(path string) error {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading config file: %v", err)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(contents, c)
+	case ".toml":
+		var meta toml.MetaData
+		meta, err = toml.Decode(string(contents), c)
+		if err == nil && len(meta.Undecoded()) > 0 {
+			err = fmt.Errorf("unknown key %s", meta.Undecoded()[0])
+		}
+	default:
+		return fmt.Errorf("config file %s must end in .yaml, .yml or .toml", path)
+	}
+	if err != nil {
+		return fmt.Errorf("parsing config file %s: %v", path, err)
+	}
+	return nil
+}
+
+// Validate reports the first setting that would stop the server from starting correctly
+func (c *Config) Validate() error {
+	if c.ListenAddr == "" {
+		return errors.New("listenAddr is required")
+	}
+	if c.ShutdownTimeout <= 0 {
+		return errors.New("shutdownTimeout must be positive")
+	}
+	if _, err := clientip.ParseProxies(c.TrustedProxies); err != nil {
+		return err
+	}
+	if c.Timeouts.Request <= 0 {
+		return errors.New("timeouts.request must be positive")
+	}
+	if c.Timeouts.Export <= 0 {
+		return errors.New("timeouts.export must be positive")
+	}
+	for field, timeout := range c.Timeouts.Overrides {
+		if timeout <= 0 {
+			return fmt.Errorf("timeouts.overrides.%s must be positive", field)
+		}
+	}
+
+	if c.DB.DSN == "" {
+		if c.DB.Host == "" {
+			return errors.New("db.host is required when db.dsn is not set")
+		}
+		if c.DB.Name == "" {
+			return errors.New("db.name is required when db.dsn is not set")
+		}
+		if c.DB.Port <= 0 || c.DB.Port > 65535 {
+			return fmt.Errorf("db.port %d is out of range", c.DB.Port)
+		}
+		if !contains(sslModes, c.DB.SSLMode) {
+			return fmt.Errorf("db.sslMode must be one of %s", strings.Join(sslModes, ", "))
+		}
+		if (c.DB.SSLCert == "") != (c.DB.SSLKey == "") {
+			return errors.New("db.sslCert and db.sslKey must be set together")
+		}
+		for _, file := range []string{c.DB.SSLCert, c.DB.SSLKey, c.DB.SSLRootCert} {
+			if file == "" {
+				continue
+			}
+			if _, err := os.Stat(file); err != nil {
+				return fmt.Errorf("db certificate: %v", err)
+			}
+		}
+	}
+	if c.DB.StatementTimeout < 0 {
+		return errors.New("db.statementTimeout cannot be negative")
+	}
+	if c.DB.MaxOpenConns < 0 || c.DB.MinConns < 0 {
+		return errors.New("db pool sizes cannot be negative")
+	}
+	if c.DB.MaxOpenConns > 0 && c.DB.MinConns > c.DB.MaxOpenConns {
+		return errors.New("db.minConns cannot exceed db.maxOpenConns")
+	}
+	if c.DB.ConnMaxLifetime < 0 {
+		return errors.New("db.connMaxLifetime cannot be negative")
+	}
+	for _, replica := range c.DB.Replicas {
+		if replica == "" {
+			return errors.New("db.replicas cannot contain an empty DSN")
+		}
+	}
+	if len(c.DB.Replicas) > 0 {
+		if c.DB.MaxReplicaLag <= 0 {
+			return errors.New("db.maxReplicaLag must be positive")
+		}
+		if c.DB.ReplicaCheckInterval < time.Second {
+			return errors.New("db.replicaCheckInterval must be at least 1s")
+		}
+	}
+
+	if c.Health.MaxIngestionLag <= 0 {
+		return errors.New("health.maxIngestionLag must be positive")
+	}
+	if len(c.CORS.AllowedOrigins) == 0 {
+		return errors.New("cors.allowedOrigins needs at least one origin")
+	}
+	if c.HTTPCache.MaxAge < time.Second {
+		return errors.New("httpCache.maxAge must be at least 1s")
+	}
+	if c.EntityCache.MaxMemoryMB < 0 {
+		return errors.New("entityCache.maxMemoryMB cannot be negative")
+	}
+	if !contains(logLevels, c.Log.Level) {
+		return fmt.Errorf("log.level must be one of %s", strings.Join(logLevels, ", "))
+	}
+
+	if c.Log.SQLSampleRate < 0 || c.Log.SQLSampleRate > 1 {
+		return errors.New("log.sqlSampleRate must be between 0 and 1")
+	}
+	if c.Metrics.ListenAddr == "" {
+		return errors.New("metrics.listenAddr is required")
+	}
+	if c.Metrics.ListenAddr == c.ListenAddr {
+		return errors.New("metrics.listenAddr must differ from listenAddr")
+	}
+	if c.Tracing.Enabled && c.Tracing.Endpoint == "" {
+		return errors.New("tracing.endpoint is required when tracing is enabled")
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		return errors.New("tracing.sampleRatio must be between 0 and 1")
+	}
+
+	limits := []struct {
+		name  string
+		value int
+	}{
+		{"limits.maxSearchLimit", c.Limits.MaxSearchLimit},
+		{"limits.maxTxnsLimit", c.Limits.MaxTxnsLimit},
+		{"limits.maxOpsLimit", c.Limits.MaxOpsLimit},
+		{"limits.maxPathHops", c.Limits.MaxPathHops},
+		{"limits.maxOrderBookOffers", c.Limits.MaxOrderBookOffers},
+		{"limits.maxPathExpansions", c.Limits.MaxPathExpansions},
+		{"limits.maxFeeStatsLedgers", c.Limits.MaxFeeStatsLedgers},
+		{"limits.maxExportLedgers", c.Limits.MaxExportLedgers},
+		{"limits.maxQueryDepth", c.Limits.MaxQueryDepth},
+		{"limits.maxQueryComplexity", c.Limits.MaxQueryComplexity},
+	}
+	for _, limit := range limits {
+		if limit.value <= 0 {
+			return fmt.Errorf("%s must be positive", limit.name)
+		}
+	}
+
+	if c.RateLimit.KeysFile != "" && c.RateLimit.KeysTable != "" {
+		return errors.New("rateLimit.keysFile and rateLimit.keysTable cannot both be set")
+	}
+	if c.RateLimit.KeysTable != "" && !tableName.MatchString(c.RateLimit.KeysTable) {
+		return fmt.Errorf("rateLimit.keysTable %q is not a valid table name", c.RateLimit.KeysTable)
+	}
+	if c.RateLimit.Anonymous.RequestsPerMinute < 0 {
+		return errors.New("rateLimit.anonymous.requestsPerMinute cannot be negative")
+	}
+	if c.RateLimit.Anonymous.RequestsPerMinute > 0 && c.RateLimit.Anonymous.ComplexityPerMinute <= 0 {
+		return errors.New("rateLimit.anonymous.complexityPerMinute must be positive")
+	}
+	if c.RateLimit.Keys.RequestsPerMinute <= 0 || c.RateLimit.Keys.ComplexityPerMinute <= 0 {
+		return errors.New("rateLimit.keys allowances must be positive")
+	}
+
+	if !contains(persistedQueryCaches, c.PersistedQueries.Cache) {
+		return fmt.Errorf("persistedQueries.cache must be one of %s", strings.Join(persistedQueryCaches, ", "))
+	}
+	if c.PersistedQueries.CacheSize <= 0 {
+		return errors.New("persistedQueries.cacheSize must be positive")
+	}
+	if c.PersistedQueries.Cache == "postgres" && !tableName.MatchString(c.PersistedQueries.Table) {
+		return fmt.Errorf("persistedQueries.table %q is not a valid table name", c.PersistedQueries.Table)
+	}
+	if c.PersistedQueries.Cache == "postgres" && c.PersistedQueries.TableMaxRows <= 0 {
+		return errors.New("persistedQueries.tableMaxRows must be positive")
+	}
+	if c.PersistedQueries.Strict && c.PersistedQueries.ManifestDir == "" {
+		return errors.New("persistedQueries.manifestDir is required in strict mode")
+	}
+	return nil
+}
+
+// ConnectionString returns the DSN, or builds a key/value connection string from its parts.
+// The statement timeout is added as a run-time parameter unless the DSN already sets one.
+func (d DB) ConnectionString() string {
+	if d.DSN != "" {
+		return withStatementTimeout(d.DSN, d.StatementTimeout)
+	}
+
+	parts := []string{
+		"host=" + quote(d.Host),
+		"port=" + strconv.Itoa(d.Port),
+		"user=" + quote(d.User),
+		"dbname=" + quote(d.Name),
+		"password=" + quote(d.Password),
+		"sslmode=" + quote(d.SSLMode),
+	}
+	if d.SSLCert != "" {
+		parts = append(parts, "sslcert="+quote(d.SSLCert), "sslkey="+quote(d.SSLKey))
+	}
+	if d.SSLRootCert != "" {
+		parts = append(parts, "sslrootcert="+quote(d.SSLRootCert))
+	}
+	return withStatementTimeout(strings.Join(parts, " "), d.StatementTimeout)
+}
+
+// ReplicaConnectionStrings returns the replica DSNs with the same statement timeout as the primary
+func (d DB) ReplicaConnectionStrings() []string {
+	dsns := make([]string, 0, len(d.Replicas))
+	for _, replica := range d.Replicas {
+		dsns = append(dsns, withStatementTimeout(replica, d.StatementTimeout))
+	}
+	return dsns
+}
+
+func withStatementTimeout(dsn string, timeout time.Duration) string {
+	if timeout <= 0 || strings.Contains(dsn, "statement_timeout") {
+		return dsn
+	}
+	milliseconds := strconv.FormatInt(timeout.Milliseconds(), 10)
+
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		u, err := url.Parse(dsn)
+		if err != nil {
+			return dsn
+		}
+		query := u.Query()
+		query.Set("statement_timeout", milliseconds)
+		u.RawQuery = query.Encode()
+		return u.String()
+	}
+	return dsn + " statement_timeout=" + milliseconds
+}
+
+// Values in a key/value connection string are single quoted with quotes and backslashes escaped
+func quote(value string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value) + "'"
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// A setting that can be overridden from the environment or the command line
+type setting struct {
+	flag  string
+	env   string
+	usage string
+	set   func(string) error
+}
+
+// The database variables keep the names the server has always read so existing deployments
+// carry on working. Everything else is prefixed with HUBBLE_.
+func (c *Config) settings() []setting {
+	return []setting{
+		{"listen", "HUBBLE_LISTEN_ADDR", "address to serve on", stringVar(&c.ListenAddr)},
+		{"shutdown-timeout", "HUBBLE_SHUTDOWN_TIMEOUT", "time allowed to drain requests on shutdown, e.g. 30s", durationVar(&c.ShutdownTimeout)},
+		{"trusted-proxies", "HUBBLE_TRUSTED_PROXIES", "comma separated addresses or CIDR ranges allowed to set X-Forwarded-For", listVar(&c.TrustedProxies)},
+		{"request-timeout", "HUBBLE_REQUEST_TIMEOUT", "deadline for each GraphQL operation, e.g. 10s", durationVar(&c.Timeouts.Request)},
+		{"timeout-overrides", "HUBBLE_TIMEOUT_OVERRIDES", "comma separated field=duration deadlines by root field, e.g. strictSendPaths=20s", durationMapVar(&c.Timeouts.Overrides)},
+		{"export-timeout", "HUBBLE_EXPORT_TIMEOUT", "deadline for each CSV export, e.g. 2m", durationVar(&c.Timeouts.Export)},
+		{"db-dsn", "DATABASE_URL", "full Postgres DSN, overrides the other db settings", stringVar(&c.DB.DSN)},
+		{"db-host", "DB_HOST", "Postgres host", stringVar(&c.DB.Host)},
+		{"db-port", "DB_PORT", "Postgres port", intVar(&c.DB.Port)},
+		{"db-user", "DB_USERNAME", "Postgres user", stringVar(&c.DB.User)},
+		{"db-password", "DB_PASSWORD", "Postgres password", stringVar(&c.DB.Password)},
+		{"db-name", "DB_DATABASE", "Postgres database name", stringVar(&c.DB.Name)},
+		{"db-sslmode", "DB_SSLMODE", "Postgres TLS mode", stringVar(&c.DB.SSLMode)},
+		{"db-sslcert", "DB_SSLCERT", "client certificate file", stringVar(&c.DB.SSLCert)},
+		{"db-sslkey", "DB_SSLKEY", "client key file", stringVar(&c.DB.SSLKey)},
+		{"db-sslrootcert", "DB_SSLROOTCERT", "root certificate file used to verify the server", stringVar(&c.DB.SSLRootCert)},
+		{"db-max-open-conns", "DB_MAX_OPEN_CONNS", "maximum open connections, 0 for the driver default", intVar(&c.DB.MaxOpenConns)},
+		{"db-min-conns", "DB_MIN_CONNS", "connections kept open while idle", intVar(&c.DB.MinConns)},
+		{"db-conn-max-lifetime", "DB_CONN_MAX_LIFETIME", "how long a connection is reused, 0 for forever", durationVar(&c.DB.ConnMaxLifetime)},
+		{"db-statement-timeout", "DB_STATEMENT_TIMEOUT", "Postgres statement_timeout, 0 to disable", durationVar(&c.DB.StatementTimeout)},
+		{"db-replicas", "DB_REPLICAS", "comma separated DSNs of read replicas for history queries", listVar(&c.DB.Replicas)},
+		{"db-max-replica-lag", "DB_MAX_REPLICA_LAG", "replication lag at which a replica stops taking reads, e.g. 30s", durationVar(&c.DB.MaxReplicaLag)},
+		{"db-replica-check-interval", "DB_REPLICA_CHECK_INTERVAL", "how often replica health and lag are checked", durationVar(&c.DB.ReplicaCheckInterval)},
+		{"max-ingestion-lag", "HUBBLE_MAX_INGESTION_LAG", "ingestion lag at which the server reports not ready, e.g. 2m", durationVar(&c.Health.MaxIngestionLag)},
+		{"cors-origins", "HUBBLE_CORS_ORIGINS", "comma separated origins allowed by CORS", listVar(&c.CORS.AllowedOrigins)},
+		{"cache-max-age", "HUBBLE_CACHE_MAX_AGE", "max-age sent with cacheable GET queries, e.g. 24h", durationVar(&c.HTTPCache.MaxAge)},
+		{"entity-cache-mb", "HUBBLE_ENTITY_CACHE_MB", "memory for cached ledgers, transactions and operations, 0 to disable", intVar(&c.EntityCache.MaxMemoryMB)},
+		{"log-level", "HUBBLE_LOG_LEVEL", "one of debug, info, warn or error", stringVar(&c.Log.Level)},
+		{"log-sql-sample-rate", "HUBBLE_LOG_SQL_SAMPLE_RATE", "fraction of SQL statements logged at debug level", floatVar(&c.Log.SQLSampleRate)},
+		{"metrics-listen", "HUBBLE_METRICS_LISTEN_ADDR", "address to serve Prometheus metrics on, apart from the API", stringVar(&c.Metrics.ListenAddr)},
+		{"metrics-operations", "HUBBLE_METRICS_OPERATIONS", "comma separated operation names reported in metrics besides the manifest's", listVar(&c.Metrics.OperationNames)},
+		{"tracing", "HUBBLE_TRACING_ENABLED", "export OpenTelemetry traces", boolVar(&c.Tracing.Enabled)},
+		{"tracing-endpoint", "OTEL_EXPORTER_OTLP_ENDPOINT", "OTLP/HTTP collector host and port", stringVar(&c.Tracing.Endpoint)},
+		{"tracing-insecure", "HUBBLE_TRACING_INSECURE", "send traces without TLS", boolVar(&c.Tracing.Insecure)},
+		{"tracing-sample-ratio", "HUBBLE_TRACING_SAMPLE_RATIO", "fraction of new traces to sample", floatVar(&c.Tracing.SampleRatio)},
+		{"tracing-service-name", "OTEL_SERVICE_NAME", "service name reported with each span", stringVar(&c.Tracing.ServiceName)},
+		{"max-search-limit", "HUBBLE_MAX_SEARCH_LIMIT", "limit when searching unconstrained data", intVar(&c.Limits.MaxSearchLimit)},
+		{"max-txns-limit", "HUBBLE_MAX_TXNS_LIMIT", "transactions returned per ledger", intVar(&c.Limits.MaxTxnsLimit)},
+		{"max-ops-limit", "HUBBLE_MAX_OPS_LIMIT", "operations returned per transaction", intVar(&c.Limits.MaxOpsLimit)},
+		{"max-path-hops", "HUBBLE_MAX_PATH_HOPS", "longest conversion chain searched by path finding", intVar(&c.Limits.MaxPathHops)},
+		{"max-order-book-offers", "HUBBLE_MAX_ORDER_BOOK_OFFERS", "offers loaded per asset pair when path finding", intVar(&c.Limits.MaxOrderBookOffers)},
+		{"max-path-expansions", "HUBBLE_MAX_PATH_EXPANSIONS", "assets a single path search may expand", intVar(&c.Limits.MaxPathExpansions)},
+		{"max-fee-stats-ledgers", "HUBBLE_MAX_FEE_STATS_LEDGERS", "ledgers fee statistics can cover", intVar(&c.Limits.MaxFeeStatsLedgers)},
+		{"max-export-ledgers", "HUBBLE_MAX_EXPORT_LEDGERS", "ledgers a single CSV export can cover", intVar(&c.Limits.MaxExportLedgers)},
+		{"max-query-depth", "HUBBLE_MAX_QUERY_DEPTH", "deepest field nesting allowed in an operation", intVar(&c.Limits.MaxQueryDepth)},
+		{"max-query-complexity", "HUBBLE_MAX_QUERY_COMPLEXITY", "total cost allowed for an operation", intVar(&c.Limits.MaxQueryComplexity)},
+		{"rate-limit", "HUBBLE_RATE_LIMIT_ENABLED", "require API keys or apply the anonymous allowance", boolVar(&c.RateLimit.Enabled)},
+		{"api-keys-file", "HUBBLE_API_KEYS_FILE", "YAML file of API keys", stringVar(&c.RateLimit.KeysFile)},
+		{"api-keys-table", "HUBBLE_API_KEYS_TABLE", "Postgres table of API keys", stringVar(&c.RateLimit.KeysTable)},
+		{"anonymous-requests-per-minute", "HUBBLE_ANONYMOUS_REQUESTS_PER_MINUTE", "requests allowed per address without a key, 0 to require keys", intVar(&c.RateLimit.Anonymous.RequestsPerMinute)},
+		{"anonymous-complexity-per-minute", "HUBBLE_ANONYMOUS_COMPLEXITY_PER_MINUTE", "complexity points allowed per address without a key", intVar(&c.RateLimit.Anonymous.ComplexityPerMinute)},
+		{"key-requests-per-minute", "HUBBLE_KEY_REQUESTS_PER_MINUTE", "requests allowed per key unless the key sets its own", intVar(&c.RateLimit.Keys.RequestsPerMinute)},
+		{"key-complexity-per-minute", "HUBBLE_KEY_COMPLEXITY_PER_MINUTE", "complexity points allowed per key unless the key sets its own", intVar(&c.RateLimit.Keys.ComplexityPerMinute)},
+		{"apq-cache", "HUBBLE_APQ_CACHE", "where persisted queries are cached, memory or postgres", stringVar(&c.PersistedQueries.Cache)},
+		{"apq-cache-size", "HUBBLE_APQ_CACHE_SIZE", "persisted queries held in memory", intVar(&c.PersistedQueries.CacheSize)},
+		{"apq-table", "HUBBLE_APQ_TABLE", "Postgres table of persisted queries", stringVar(&c.PersistedQueries.Table)},
+		{"apq-table-max-rows", "HUBBLE_APQ_TABLE_MAX_ROWS", "persisted queries kept in the Postgres table at most", intVar(&c.PersistedQueries.TableMaxRows)},
+		{"operation-manifest", "HUBBLE_OPERATION_MANIFEST", "directory of .graphql files registering operations", stringVar(&c.PersistedQueries.ManifestDir)},
+		{"operation-manifest-strict", "HUBBLE_OPERATION_MANIFEST_STRICT", "only run operations from the manifest", boolVar(&c.PersistedQueries.Strict)},
+	}
+}
+
+func stringVar(p *string) func(string) error {
+	return func(value string) error {
+		*p = value
+		return nil
+	}
+}
+
+func intVar(p *int) func(string) error {
+	return func(value string) error {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		*p = i
+		return nil
+	}
+}
+
+func boolVar(p *bool) func(string) error {
+	return func(value string) error {
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		*p = b
+		return nil
+	}
+}
+
+func floatVar(p *float64) func(string) error {
+	return func(value string) error {
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		*p = f
+		return nil
+	}
+}
+
+func durationVar(p *time.Duration) func(string) error {
+	return func(value string) error {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		*p = d
+		return nil
+	}
+}
+
+func listVar(p *[]string) func(string) error {
+	return func(value string) error {
+		list := []string{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		*p = list
+		return nil
+	}
+}
+
+func durationMapVar(p *map[string]time.Duration) func(string) error {
+	return func(value string) error {
+		m := map[string]time.Duration{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item == "" {
+				continue
+			}
+			parts := strings.SplitN(item, "=", 2)
+			if len(parts) != 2 {
+				return fmt.Errorf("expected name=duration, got %q", item)
+			}
+			d, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+			if err != nil {
+				return err
+			}
+			m[strings.TrimSpace(parts[0])] = d
+		}
+		*p = m
+		return nil
+	}
+}
@@ -0,0 +1,62 @@
+// Package database opens the pgx connection pools for the primary and its read replicas.
+// Every query takes the request's context, so statements are cancelled along with it.
+package database
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type Options struct {
+	// Zero keeps the pgx default of four or the number of CPUs, whichever is more
+	MaxConns int
+	// Connections kept open while idle
+	MinConns int
+	// Connections are replaced after this long, zero keeps them forever
+	MaxConnLifetime time.Duration
+	// Sees every query, for logging, metrics and tracing
+	Tracer pgx.QueryTracer
+}
+
+// Open connects a pool and checks that the database answers
+func Open(ctx context.Context, dsn string, opts Options) (*pgxpool.Pool, error) {
+	config, err := pgxpool.ParseConfig(dsn)
+	if err != nil {
+		return nil, err
+	}
+	if opts.MaxConns > 0 {
+		config.MaxConns = int32(opts.MaxConns)
+	}
+	config.MinConns = int32(opts.MinConns)
+	if opts.MaxConnLifetime > 0 {
+		config.MaxConnLifetime = opts.MaxConnLifetime
+	} else {
+		config.MaxConnLifetime = math.MaxInt64
+	}
+	config.ConnConfig.Tracer = opts.Tracer
+
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return pool, nil
+}
+
+var tableName = regexp.MustCompile(`(?i)\b(?:from|into|update)\s+([a-z_][a-z0-9_.]*)`)
+
+// Table returns the first table a statement reads or writes, or "unknown"
+func Table(sql string) string {
+	if match := tableName.FindStringSubmatch(sql); match != nil {
+		return match[1]
+	}
+	return "unknown"
+}
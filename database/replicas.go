@@ -0,0 +1,101 @@
+package database
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// Replicas spreads history reads over read replicas in turn. A replica only takes reads while
+// it answers health checks and its latest ledger closed no more than maxLag before the
+// primary's. A nil Replicas has no replicas.
+type Replicas struct {
+	primary  *pgxpool.Pool
+	replicas []*replica
+	maxLag   time.Duration
+	next     atomic.Uint64
+}
+
+type replica struct {
+	name    string
+	db      *pgxpool.Pool
+	healthy atomic.Bool
+}
+
+// Replicas start out unhealthy, so Check should run once before serving
+func NewReplicas(primary *pgxpool.Pool, replicas map[string]*pgxpool.Pool, maxLag time.Duration) *Replicas {
+	r := &Replicas{primary: primary, maxLag: maxLag}
+	for name, db := range replicas {
+		r.replicas = append(r.replicas, &replica{name: name, db: db})
+	}
+	return r
+}
+
+// Reader returns the next healthy replica, or nil when none is
+func (r *Replicas) Reader() *pgxpool.Pool {
+	if r == nil {
+		return nil
+	}
+	for range r.replicas {
+		next := r.replicas[r.next.Add(1)%uint64(len(r.replicas))]
+		if next.healthy.Load() {
+			return next.db
+		}
+	}
+	return nil
+}
+
+// Watch checks the replicas every interval until ctx is done
+func (r *Replicas) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.Check(ctx)
+		}
+	}
+}
+
+// Check measures each replica's lag behind the primary. While the primary can't be reached
+// every replica that answers is used, lagging or not.
+func (r *Replicas) Check(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	primaryClosedAt, primaryErr := latestClosedAt(ctx, r.primary)
+	if primaryErr != nil {
+		slog.WarnContext(ctx, "checking primary for replica lag", "error", primaryErr)
+	}
+	for _, replica := range r.replicas {
+		closedAt, err := latestClosedAt(ctx, replica.db)
+		healthy := err == nil
+		lag := time.Duration(0)
+		if healthy && primaryErr == nil {
+			lag = primaryClosedAt.Sub(closedAt)
+			healthy = lag <= r.maxLag
+		}
+
+		if replica.healthy.Swap(healthy) != healthy {
+			if healthy {
+				slog.InfoContext(ctx, "replica taking reads", "replica", replica.name, "lag", lag.String())
+			} else if err != nil {
+				slog.WarnContext(ctx, "replica removed from reads", "replica", replica.name, "error", err)
+			} else {
+				slog.WarnContext(ctx, "replica removed from reads", "replica", replica.name, "lag", lag.String())
+			}
+		}
+	}
+}
+
+// Close time of the latest ledger ingested into db
+func latestClosedAt(ctx context.Context, db *pgxpool.Pool) (time.Time, error) {
+	var closedAt time.Time
+	err := db.QueryRow(ctx, "SELECT closed_at FROM history_ledgers ORDER BY sequence DESC LIMIT 1").Scan(&closedAt)
+	return closedAt, err
+}
@@ -0,0 +1,152 @@
+// Package entitycache keeps recently read history rows in memory. Ledgers, transactions and
+// operations never change once ingested, so a cached row is never stale and needs no expiry.
+// Live state such as accounts and trust lines must not be cached here.
+package entitycache
+
+import (
+	"container/list"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/owenjacob/hubblegraphql/metrics"
+)
+
+// Cache is a least recently used cache bounded by the estimated memory of its entries. A nil
+// Cache is valid and never holds anything, which is how caching is turned off.
+type Cache struct {
+	maxBytes int64
+
+	mu      sync.Mutex
+	bytes   int64
+	entries *list.List
+	index   map[key]*list.Element
+}
+
+// Entities of different kinds can share ids, e.g. a transaction and an operation
+type key struct {
+	kind string
+	id   string
+}
+
+type entry struct {
+	key   key
+	value interface{}
+	size  int64
+}
+
+// Bookkeeping for each entry: the list element, the map slot and the key strings
+const entryOverhead = 160
+
+// New returns nil when maxBytes is zero or less
+func New(maxBytes int64) *Cache {
+	if maxBytes <= 0 {
+		return nil
+	}
+	return &Cache{
+		maxBytes: maxBytes,
+		entries:  list.New(),
+		index:    map[key]*list.Element{},
+	}
+}
+
+// Get returns the entity of the given kind stored under id. Values are shared between
+// callers and must not be modified.
+func (c *Cache) Get(kind string, id string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	element, ok := c.index[key{kind, id}]
+	if ok {
+		c.entries.MoveToFront(element)
+	}
+	c.mu.Unlock()
+
+	metrics.EntityCacheLookup(kind, ok)
+	if !ok {
+		return nil, false
+	}
+	return element.Value.(*entry).value, true
+}
+
+// Add stores value, evicting the least recently used entries until it fits. Values larger
+// than a tenth of the cap aren't worth the space and are skipped.
+func (c *Cache) Add(kind string, id string, value interface{}) {
+	if c == nil {
+		return
+	}
+	k := key{kind, id}
+	size := Size(value) + int64(len(kind)+len(id)) + entryOverhead
+	if size > c.maxBytes/10 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if element, ok := c.index[k]; ok {
+		c.entries.MoveToFront(element)
+		return
+	}
+	c.index[k] = c.entries.PushFront(&entry{key: k, value: value, size: size})
+	c.bytes += size
+
+	evicted := 0
+	for c.bytes > c.maxBytes {
+		oldest := c.entries.Back()
+		e := c.entries.Remove(oldest).(*entry)
+		delete(c.index, e.key)
+		c.bytes -= e.size
+		evicted++
+	}
+	metrics.EntityCacheEvicted(evicted, c.bytes)
+}
+
+// Size estimates the memory held by v, following pointers, slices and strings. Maps and
+// channels aren't expected in rows and only count their header.
+func Size(v interface{}) int64 {
+	if v == nil {
+		return 0
+	}
+	value := reflect.ValueOf(v)
+	return int64(value.Type().Size()) + referencedSize(value)
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Memory reachable from value besides value itself
+func referencedSize(value reflect.Value) int64 {
+	switch value.Kind() {
+	case reflect.String:
+		return int64(value.Len())
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return 0
+		}
+		elem := value.Elem()
+		return int64(elem.Type().Size()) + referencedSize(elem)
+	case reflect.Slice:
+		size := int64(value.Cap()) * int64(value.Type().Elem().Size())
+		for i := 0; i < value.Len(); i++ {
+			size += referencedSize(value.Index(i))
+		}
+		return size
+	case reflect.Array:
+		var size int64
+		for i := 0; i < value.Len(); i++ {
+			size += referencedSize(value.Index(i))
+		}
+		return size
+	case reflect.Struct:
+		// Locations are shared by every time in the same zone
+		if value.Type() == timeType {
+			return 0
+		}
+		var size int64
+		for i := 0; i < value.NumField(); i++ {
+			size += referencedSize(value.Field(i))
+		}
+		return size
+	}
+	return 0
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/owenjacob/hubblegraphql/ratelimit"
+)
+
+type transactionExporter interface {
+	ExportTransactions(ctx context.Context, w io.Writer, firstLedger int, lastLedger int) error
+}
+
+// Streams the transactions of the ledgers from the from query parameter to the to parameter
+// inclusive as CSV, at most maxLedgers of them per request. Each export counts as one request
+// against the client's rate limit, and is cut off after timeout.
+func exportTransactionsHandler(exporter transactionExporter, maxLedgers int, timeout time.Duration, limiter *ratelimit.Limiter) httprouter.Handle {
+	var h http.Handler = http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		from, fromErr := strconv.Atoi(req.URL.Query().Get("from"))
+		to, toErr := strconv.Atoi(req.URL.Query().Get("to"))
+		if fromErr != nil || toErr != nil || from < 1 || to < from {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "from and to must be ledger sequences with from <= to"})
+			return
+		}
+		if to-from+1 > maxLedgers {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "at most " + strconv.Itoa(maxLedgers) + " ledgers can be exported at once"})
+			return
+		}
+
+		// The context stops the COPY, and the write deadline stops a slow client holding the
+		// connection once the COPY is blocked writing to it
+		ctx, cancel := context.WithTimeout(req.Context(), timeout)
+		defer cancel()
+		http.NewResponseController(w).SetWriteDeadline(time.Now().Add(timeout))
+
+		csv := &csvWriter{ResponseWriter: w, filename: "transactions-" + strconv.Itoa(from) + "-" + strconv.Itoa(to) + ".csv"}
+		if err := exporter.ExportTransactions(ctx, csv, from, to); err != nil {
+			slog.ErrorContext(req.Context(), "exporting transactions", "from", from, "to", to, "error", err)
+			// Once rows have been written the status can't change, so a failure part way
+			// through only shows up as a truncated file
+			if !csv.started {
+				writeJSON(w, http.StatusServiceUnavailable, map[string]string{"error": "export failed"})
+			}
+		}
+	})
+	if limiter != nil {
+		h = ratelimit.Middleware(limiter, h)
+	}
+
+	return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+		h.ServeHTTP(w, req)
+	}
+}
+
+// Sends the CSV headers with the first rows, so an export that fails before then can still
+// answer with an error
+type csvWriter struct {
+	http.ResponseWriter
+	filename string
+	started  bool
+}
+
+func (w *csvWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.started = true
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="`+w.filename+`"`)
+		w.Header().Set("Cache-Control", "no-store")
+	}
+	return w.ResponseWriter.Write(p)
+}
@@ -0,0 +1,196 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/owenjacob/hubblegraphql/apierror"
+	"github.com/owenjacob/hubblegraphql/graph/model"
+)
+
+// Asset types as numbered in the XDR and in Horizon's state tables
+const (
+	assetTypeNative           = 0
+	assetTypeCreditAlphanum4  = 1
+	assetTypeCreditAlphanum12 = 2
+	// Only held in trust lines, and can't be traded or paid
+	assetTypePoolShare = 3
+)
+
+// StrKey version byte for ed25519 public keys, which gives account ids their leading G
+const accountIDVersionByte = 6 << 3
+
+type asset struct {
+	Type   int
+	Code   string
+	Issuer string
+}
+
+var nativeAsset = asset{Type: assetTypeNative}
+
+// Canonical form used for asset arguments: either "native" or "CODE:ISSUER"
+func (a asset) String() string {
+	if a.Type == assetTypeNative {
+		return "native"
+	}
+	return a.Code + ":" + a.Issuer
+}
+
+func (a asset) model() *model.Asset {
+	if a.Type == assetTypeNative {
+		return &model.Asset{Type: model.AssetTypeNative}
+	}
+
+	assetType := model.AssetTypeCreditAlphanum4
+	if a.Type == assetTypeCreditAlphanum12 {
+		assetType = model.AssetTypeCreditAlphanum12
+	}
+	code := a.Code
+	issuer := a.Issuer
+	return &model.Asset{
+		Type:   assetType,
+		Code:   &code,
+		Issuer: &issuer,
+	}
+}
+
+func parseAsset(canonical string) (asset, error) {
+	if canonical == "native" {
+		return nativeAsset, nil
+	}
+
+	parts := strings.Split(canonical, ":")
+	if len(parts) != 2 {
+		return asset{}, apierror.Errorf(apierror.InvalidArgument, "Invalid asset %q, expected native or CODE:ISSUER", canonical)
+	}
+	code, issuer := parts[0], parts[1]
+	if len(code) == 0 || len(code) > 12 {
+		return asset{}, apierror.Errorf(apierror.InvalidArgument, "Invalid asset code %q", code)
+	}
+	for _, c := range code {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return asset{}, apierror.Errorf(apierror.InvalidArgument, "Invalid asset code %q", code)
+		}
+	}
+	if _, err := decodeAccountID(issuer); err != nil {
+		return asset{}, apierror.Errorf(apierror.InvalidArgument, "Invalid asset issuer %q", issuer)
+	}
+
+	assetType := assetTypeCreditAlphanum4
+	if len(code) > 4 {
+		assetType = assetTypeCreditAlphanum12
+	}
+	return asset{Type: assetType, Code: code, Issuer: issuer}, nil
+}
+
+// Encodes the asset as base64 XDR, which is how the offers table stores assets
+func (a asset) xdr() string {
+	buf := &bytes.Buffer{}
+	binary.Write(buf, binary.BigEndian, int32(a.Type))
+	if a.Type != assetTypeNative {
+		codeLength := 4
+		if a.Type == assetTypeCreditAlphanum12 {
+			codeLength = 12
+		}
+		code := make([]byte, codeLength)
+		copy(code, a.Code)
+		buf.Write(code)
+
+		// Issuer is a PublicKey union of which ed25519 is the only arm
+		key, _ := decodeAccountID(a.Issuer)
+		binary.Write(buf, binary.BigEndian, int32(0))
+		buf.Write(key)
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func decodeAssetXDR(encoded string) (asset, error) {
+	raw, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return asset{}, err
+	}
+	if len(raw) < 4 {
+		return asset{}, errors.New("asset xdr too short")
+	}
+
+	assetType := int(binary.BigEndian.Uint32(raw[:4]))
+	codeLength := 0
+	switch assetType {
+	case assetTypeNative:
+		return nativeAsset, nil
+	case assetTypeCreditAlphanum4:
+		codeLength = 4
+	case assetTypeCreditAlphanum12:
+		codeLength = 12
+	default:
+		return asset{}, fmt.Errorf("unsupported asset type %d", assetType)
+	}
+
+	if len(raw) != 4+codeLength+4+32 {
+		return asset{}, errors.New("invalid asset xdr length")
+	}
+	code := strings.TrimRight(string(raw[4:4+codeLength]), "\x00")
+	key := raw[4+codeLength+4:]
+	return asset{Type: assetType, Code: code, Issuer: encodeAccountID(key)}, nil
+}
+
+func encodeAccountID(key []byte) string {
+	return encodeStrKey(accountIDVersionByte, key)
+}
+
+// StrKey encodes data with the version byte that gives it its leading letter
+func encodeStrKey(versionByte byte, data []byte) string {
+	payload := append([]byte{versionByte}, data...)
+	checksum := make([]byte, 2)
+	binary.LittleEndian.PutUint16(checksum, crc16(payload))
+	return base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(append(payload, checksum...))
+}
+
+func decodeAccountID(address string) ([]byte, error) {
+	raw, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(address)
+	if err != nil {
+		return nil, err
+	}
+	if len(raw) != 35 || raw[0] != accountIDVersionByte {
+		return nil, errors.New("not an account id")
+	}
+	if binary.LittleEndian.Uint16(raw[33:]) != crc16(raw[:33]) {
+		return nil, errors.New("invalid account id checksum")
+	}
+	return raw[1:33], nil
+}
+
+// CRC16-XModem checksum used by StrKey
+func crc16(data []byte) uint16 {
+	var crc uint16
+	for _, b := range data {
+		crc ^= uint16(b) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// Parses a decimal amount with up to seven places into stroops
+func parseAmount(amount string) (int64, error) {
+	value, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return 0, apierror.Errorf(apierror.InvalidArgument, "Invalid amount %q", amount)
+	}
+	value.Mul(value, big.NewRat(10000000, 1))
+	if !value.IsInt() || value.Sign() <= 0 || !value.Num().IsInt64() {
+		return 0, apierror.Errorf(apierror.InvalidArgument, "Invalid amount %q", amount)
+	}
+	return value.Num().Int64(), nil
+}
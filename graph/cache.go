@@ -0,0 +1,15 @@
+package graph
+
+// Finalized reports whether a root field, given its arguments, only reads history that can
+// no longer change. Nothing reachable from Ledger, Transaction or Operation touches live
+// account state, so their whole subtrees are safe to cache.
+func Finalized(field string, args map[string]interface{}) bool {
+	switch field {
+	case "transaction", "operation", "__typename":
+		return true
+	case "ledger":
+		// Without a number this is the latest ledger, which moves every few seconds
+		return args["number"] != nil
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+package graph
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/owenjacob/hubblegraphql/apierror"
+	"github.com/owenjacob/hubblegraphql/graph/generated"
+	"github.com/owenjacob/hubblegraphql/httpcache"
+	"github.com/owenjacob/hubblegraphql/ratelimit"
+)
+
+// A cacheable response is served by the CDN to every client, so it mustn't carry the rate
+// limit usage of whoever happened to fetch it first
+func TestCacheableResponseOmitsRateLimitHeaders(t *testing.T) {
+	h := handler.New(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  &Resolver{Stores: NewMemoryStores(fixtures()), Limits: DefaultLimits},
+		Complexity: Complexity(DefaultLimits),
+	}))
+	h.AddTransport(transport.GET{})
+	h.SetErrorPresenter(apierror.Presenter)
+	h.Use(httpcache.Extension{Finalized: Finalized})
+	h.Use(ratelimit.Extension{})
+	limiter := ratelimit.New(ratelimit.NoKeys{}, ratelimit.Tier{RequestsPerMinute: 100, ComplexityPerMinute: 10000})
+	server := ratelimit.Middleware(limiter, httpcache.Middleware(time.Hour, h))
+
+	get := func(query string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/query?query="+url.QueryEscape(query), nil)
+		res := httptest.NewRecorder()
+		server.ServeHTTP(res, req)
+		if res.Code != http.StatusOK {
+			t.Fatalf("%s: status %d\n%s", query, res.Code, res.Body.String())
+		}
+		return res
+	}
+	rateLimitHeaders := func(res *httptest.ResponseRecorder) []string {
+		var names []string
+		for name := range res.Header() {
+			if strings.HasPrefix(name, "X-Ratelimit-") {
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+
+	cached := get("{ ledger(number: 103) { sequence } }")
+	if cacheControl := cached.Header().Get("Cache-Control"); !strings.HasPrefix(cacheControl, "public") {
+		t.Fatalf("closed ledger isn't cacheable, Cache-Control is %q", cacheControl)
+	}
+	if names := rateLimitHeaders(cached); len(names) > 0 {
+		t.Errorf("cacheable response carries %v", names)
+	}
+
+	latest := get("{ ledger { sequence } }")
+	if cacheControl := latest.Header().Get("Cache-Control"); cacheControl != "no-store" {
+		t.Fatalf("latest ledger has Cache-Control %q", cacheControl)
+	}
+	if names := rateLimitHeaders(latest); len(names) == 0 {
+		t.Error("uncacheable response has no rate limit headers")
+	}
+}
@@ -0,0 +1,184 @@
+package graph
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// Every row type with the Horizon table it is read from, and the statement that reads it when
+// there is one. Rows are scanned by column name, so a tag that doesn't name a real column, or
+// a statement that selects under a different name, only fails at query time.
+var modelTables = []struct {
+	row   any
+	table string
+	query string
+}{
+	{HistoryLedgers{}, "history_ledgers", ledgerBySequenceQuery},
+	{LedgerCapacity{}, "history_ledgers", ledgerCapacityQuery},
+	{HistoryTransactions{}, "history_transactions", transactionByIDQuery},
+	{TransactionFee{}, "history_transactions", transactionFeesQuery},
+	{HistoryOperations{}, "history_operations", operationByIDQuery},
+	{HistoryAccounts{}, "history_accounts", ""},
+	{HistoryTransactionParticipants{}, "history_transaction_participants", ""},
+	{HistoryOperationParticipants{}, "history_operation_participants", ""},
+	{Account{}, "accounts", accountQuery},
+	{TrustLine{}, "trust_lines", trustLinesQuery},
+	{AccountSigner{}, "accounts_signers", signersQuery},
+	{AccountData{}, "accounts_data", dataByNameQuery},
+	{ClaimableBalance{}, "claimable_balances", "SELECT " + claimableBalanceColumns + " FROM claimable_balances"},
+	{Offer{}, "offers", offersSellingQuery},
+	{LiquidityPool{}, "liquidity_pools", liquidityPoolsQuery},
+}
+
+var columnName = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+func TestModelColumns(t *testing.T) {
+	tables := horizonTables(t)
+
+	for _, m := range modelTables {
+		rowType := reflect.TypeOf(m.row)
+		columns, ok := tables[m.table]
+		if !ok {
+			t.Errorf("%s: no table %s in the Horizon schema", rowType.Name(), m.table)
+			continue
+		}
+
+		tags := map[string]bool{}
+		for i := 0; i < rowType.NumField(); i++ {
+			field := rowType.Field(i)
+			tag := field.Tag.Get("db")
+			switch {
+			case !columnName.MatchString(tag):
+				t.Errorf("%s.%s: db tag %q is not a column name", rowType.Name(), field.Name, tag)
+			case !columns[tag]:
+				t.Errorf("%s.%s: %s has no column %q", rowType.Name(), field.Name, m.table, tag)
+			}
+			tags[tag] = true
+		}
+
+		if m.query == "" {
+			continue
+		}
+		selected := selectedColumns(m.query)
+		for name, source := range selected {
+			if !tags[name] {
+				t.Errorf("%s: selects %q, which no field is tagged with", rowType.Name(), name)
+			}
+			if !columns[source] {
+				t.Errorf("%s: selects %q from %s, which has no such column", rowType.Name(), source, m.table)
+			}
+		}
+		for tag := range tags {
+			if _, ok := selected[tag]; !ok {
+				t.Errorf("%s: the query doesn't select %q", rowType.Name(), tag)
+			}
+		}
+	}
+}
+
+// Columns of each table created by testdata/horizon_schema.sql. That file isn't generated, it
+// was transcribed from Horizon 2.8's migrations, so this only catches tags that disagree with
+// it. The file's header says where to check it against when Horizon's schema moves.
+func horizonTables(t *testing.T) map[string]map[string]bool {
+	schema, err := os.ReadFile("testdata/horizon_schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tables := map[string]map[string]bool{}
+	var columns map[string]bool
+	for _, line := range strings.Split(string(schema), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "CREATE TABLE "):
+			columns = map[string]bool{}
+			tables[strings.Fields(line)[2]] = columns
+		case strings.HasPrefix(line, ");"):
+			columns = nil
+		case columns != nil && line != "":
+			columns[strings.Fields(line)[0]] = true
+		}
+	}
+	if len(tables) == 0 {
+		t.Fatal("no tables in testdata/horizon_schema.sql")
+	}
+	return tables
+}
+
+// Maps the name each column of a SELECT comes out under to the column it is read from, for
+// select lists made of columns that are optionally cast, wrapped in COALESCE and renamed
+func selectedColumns(query string) map[string]string {
+	query = strings.Join(strings.Fields(query), " ")
+	list := query[strings.Index(query, "SELECT ")+len("SELECT ") : strings.Index(query, " FROM ")]
+
+	var items []string
+	depth, start := 0, 0
+	for i, c := range list {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				items = append(items, list[start:i])
+				start = i + 1
+			}
+		}
+	}
+	items = append(items, list[start:])
+
+	selected := map[string]string{}
+	for _, item := range items {
+		item = strings.TrimSpace(item)
+		expression, name, renamed := strings.Cut(item, " AS ")
+		source := strings.TrimPrefix(expression, "COALESCE(")
+		source = strings.FieldsFunc(source, func(r rune) bool { return r == ',' || r == ':' || r == ')' })[0]
+		if !renamed {
+			name = source
+		}
+		selected[name] = source
+	}
+	return selected
+}
+
+// Any struct with db tags is a row, and has to be in modelTables to be checked
+func TestModelTablesCoverRows(t *testing.T) {
+	covered := map[string]bool{}
+	for _, m := range modelTables {
+		covered[reflect.TypeOf(m.row).Name()] = true
+	}
+
+	packages, err := parser.ParseDir(token.NewFileSet(), ".", func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range packages["graph"].Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			fields, ok := spec.Type.(*ast.StructType)
+			if !ok || covered[spec.Name.Name] {
+				return false
+			}
+			for _, field := range fields.Fields.List {
+				if field.Tag != nil && strings.Contains(field.Tag.Value, `db:"`) {
+					t.Errorf("%s has db tags but isn't in modelTables", spec.Name.Name)
+					break
+				}
+			}
+			return false
+		})
+	}
+}
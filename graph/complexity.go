@@ -0,0 +1,170 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/owenjacob/hubblegraphql/apierror"
+	"github.com/owenjacob/hubblegraphql/graph/generated"
+	"github.com/owenjacob/hubblegraphql/graph/model"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Each hop of a path search walks another layer of the order book, so it is charged on top of
+// the paths returned
+const pathHopCost = 100
+
+// Account lists aren't paged, but the protocol caps them: an account has at most 1000 subentries
+// (trustlines, offers, data entries and signers) and 20 signers besides its master key
+const (
+	maxAccountSubentries = 1000
+	maxAccountSigners    = 21
+)
+
+// Complexity prices list fields by the number of items they can return, so nested lists multiply.
+// Fields not listed here cost one plus their children, which is gqlgen's default.
+func Complexity(limits Limits) generated.ComplexityRoot {
+	c := generated.ComplexityRoot{}
+
+	c.Query.Ledger = func(childComplexity int, number *int, limit *int, order *model.Order, filterBy *model.FilterBy) int {
+		if number != nil {
+			return listComplexity(childComplexity, 1)
+		}
+		return listComplexity(childComplexity, intArg(limit))
+	}
+	c.Query.StrictSendPaths = func(childComplexity int, sourceAsset string, sourceAmount string, destinationAssets []string, destinationAccount *string, maxHops *int, limit *int) int {
+		return saturatingAdd(listComplexity(childComplexity, intArg(limit)), saturatingMul(pathHopCost, intArg(maxHops)))
+	}
+	c.Query.StrictReceivePaths = func(childComplexity int, sourceAssets []string, sourceAccount *string, destinationAsset string, destinationAmount string, maxHops *int, limit *int) int {
+		return saturatingAdd(listComplexity(childComplexity, intArg(limit)), saturatingMul(pathHopCost, intArg(maxHops)))
+	}
+	// Fee statistics read every transaction in the ledgers they cover
+	c.Query.FeeStats = func(childComplexity int, ledgers *int) int {
+		return saturatingAdd(childComplexity+1, saturatingMul(intArg(ledgers), limits.MaxTxnsLimit))
+	}
+
+	// The native balance comes on top of one per trustline
+	c.Account.Balances = func(childComplexity int) int {
+		return listComplexity(childComplexity, maxAccountSubentries+1)
+	}
+	c.Account.Signers = func(childComplexity int) int {
+		return listComplexity(childComplexity, maxAccountSigners)
+	}
+	c.Account.Data = func(childComplexity int, name *string, namePrefix *string) int {
+		if name != nil {
+			return listComplexity(childComplexity, 1)
+		}
+		return listComplexity(childComplexity, maxAccountSubentries)
+	}
+	c.Account.Transactions = func(childComplexity int, limit *int, order *model.Order, filterBy *model.FilterBy) int {
+		return listComplexity(childComplexity, intArg(limit))
+	}
+	c.Account.Sponsoring = func(childComplexity int, limit *int) int {
+		return listComplexity(childComplexity, intArg(limit))
+	}
+	c.Account.SponsoredBy = func(childComplexity int, limit *int) int {
+		return listComplexity(childComplexity, intArg(limit))
+	}
+	c.Ledger.Transactions = func(childComplexity int, limit *int, order *model.Order) int {
+		return listComplexity(childComplexity, intArg(limit))
+	}
+	c.Transaction.Operations = func(childComplexity int, limit *int, order *model.Order) int {
+		return listComplexity(childComplexity, intArg(limit))
+	}
+	return c
+}
+
+func intArg(value *int) int {
+	if value == nil || *value < 0 {
+		return 0
+	}
+	return *value
+}
+
+func listComplexity(childComplexity int, items int) int {
+	return saturatingAdd(1, saturatingMul(childComplexity, items))
+}
+
+const maxComplexity = int(^uint(0) >> 1)
+
+// Large limits nested a few levels deep overflow quickly, so costs stop at the largest int
+func saturatingAdd(a, b int) int {
+	if a > maxComplexity-b {
+		return maxComplexity
+	}
+	return a + b
+}
+
+func saturatingMul(a, b int) int {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	if a > maxComplexity/b {
+		return maxComplexity
+	}
+	return a * b
+}
+
+// DepthLimit rejects operations whose fields nest deeper than Limit. Introspection fields are not
+// counted so that tools like the playground can still load the schema.
+type DepthLimit struct {
+	Limit int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = DepthLimit{}
+
+func (DepthLimit) ExtensionName() string {
+	return "DepthLimit"
+}
+
+func (DepthLimit) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d DepthLimit) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+
+	depth := selectionDepth(op.SelectionSet)
+	if depth > d.Limit {
+		return graphql.DefaultErrorPresenter(ctx, &apierror.Error{
+			Code:    apierror.LimitExceeded,
+			Message: fmt.Sprintf("operation has depth %d, which exceeds the limit of %d", depth, d.Limit),
+			Details: map[string]interface{}{"depth": depth, "maxDepth": d.Limit},
+		})
+	}
+	return nil
+}
+
+// Fragments are transparent, only fields add a level
+func selectionDepth(selectionSet ast.SelectionSet) int {
+	depth := 0
+	for _, selection := range selectionSet {
+		var childDepth int
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, "__") {
+				continue
+			}
+			childDepth = 1 + selectionDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				childDepth = selectionDepth(s.Definition.SelectionSet)
+			}
+		case *ast.InlineFragment:
+			childDepth = selectionDepth(s.SelectionSet)
+		}
+		if childDepth > depth {
+			depth = childDepth
+		}
+	}
+	return depth
+}
@@ -0,0 +1,221 @@
+package graph
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Envelope types that wrap a transaction
+const (
+	envelopeTypeTxV0      = 0
+	envelopeTypeTx        = 2
+	envelopeTypeTxFeeBump = 5
+)
+
+// Arms of the Preconditions union
+const (
+	preconditionsNone = 0
+	preconditionsTime = 1
+	preconditionsV2   = 2
+)
+
+// Kinds of signer key and the StrKey version bytes that give them their leading letter
+const (
+	signerKeyTypeEd25519       = 0
+	signerKeyTypePreAuthTx     = 1
+	signerKeyTypeHashX         = 2
+	signerKeyTypeSignedPayload = 3
+
+	preAuthTxVersionByte     = 19 << 3
+	hashXVersionByte         = 23 << 3
+	signedPayloadVersionByte = 15 << 3
+)
+
+// What a transaction's envelope says about when it is valid, apart from its time bounds which
+// Horizon also keeps in the time_bounds column. Zero values mean the condition isn't set.
+type envelopePreconditions struct {
+	// Min and max ledger, a max of 0 is unbounded
+	LedgerBounds *[2]uint32
+	MinSequence  *int64
+	// Seconds
+	MinSequenceAge       uint64
+	MinSequenceLedgerGap uint32
+	// StrKeys
+	ExtraSigners []string
+}
+
+// Reads the preconditions of the transaction in a base64 TransactionEnvelope, the inner
+// transaction's for a fee bump. Nil when the transaction has none, not even time bounds. Only
+// the envelope up to the preconditions is decoded.
+func parseEnvelopePreconditions(envelope string) (*envelopePreconditions, error) {
+	raw, err := base64.StdEncoding.DecodeString(envelope)
+	if err != nil {
+		return nil, err
+	}
+	r := &xdrReader{raw: raw}
+
+	envelopeType := r.uint32()
+	if envelopeType == envelopeTypeTxFeeBump {
+		// Fee source, fee, then the inner transaction's own envelope
+		r.muxedAccount()
+		r.uint64()
+		envelopeType = r.uint32()
+		if envelopeType != envelopeTypeTx && r.err == nil {
+			return nil, fmt.Errorf("unsupported inner envelope type %d", envelopeType)
+		}
+	}
+
+	var conditions *envelopePreconditions
+	switch envelopeType {
+	case envelopeTypeTxV0:
+		// Source key, fee, sequence number and optional time bounds
+		r.bytes(32)
+		r.uint32()
+		r.uint64()
+		if r.optional() {
+			conditions = &envelopePreconditions{}
+		}
+	case envelopeTypeTx:
+		r.muxedAccount()
+		r.uint32()
+		r.uint64()
+		conditions = r.preconditions()
+	default:
+		return nil, fmt.Errorf("unsupported envelope type %d", envelopeType)
+	}
+	if r.err != nil {
+		return nil, r.err
+	}
+	return conditions, nil
+}
+
+var errShortXDR = errors.New("xdr ends early")
+
+// Decodes XDR values in order. After the first error every read returns zero and the error
+// is kept in err.
+type xdrReader struct {
+	raw []byte
+	err error
+}
+
+func (r *xdrReader) bytes(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || len(r.raw) < n {
+		r.err = errShortXDR
+		return nil
+	}
+	b := r.raw[:n]
+	r.raw = r.raw[n:]
+	return b
+}
+
+func (r *xdrReader) uint32() uint32 {
+	b := r.bytes(4)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+func (r *xdrReader) uint64() uint64 {
+	b := r.bytes(8)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+// Whether an optional value follows
+func (r *xdrReader) optional() bool {
+	return r.uint32() != 0
+}
+
+// Variable length opaque data, padded to a multiple of four bytes
+func (r *xdrReader) opaque() []byte {
+	length := int(r.uint32())
+	b := r.bytes(length)
+	r.bytes((4 - length%4) % 4)
+	return b
+}
+
+func (r *xdrReader) muxedAccount() {
+	switch keyType := r.uint32(); keyType {
+	case 0:
+		r.bytes(32)
+	case 0x100:
+		// Muxed id, then the key
+		r.bytes(8 + 32)
+	default:
+		r.fail("unsupported muxed account type %d", keyType)
+	}
+}
+
+func (r *xdrReader) preconditions() *envelopePreconditions {
+	switch conditionType := r.uint32(); conditionType {
+	case preconditionsNone:
+		return nil
+	case preconditionsTime:
+		r.bytes(16)
+		return &envelopePreconditions{}
+	case preconditionsV2:
+		conditions := &envelopePreconditions{}
+		if r.optional() {
+			r.bytes(16)
+		}
+		if r.optional() {
+			conditions.LedgerBounds = &[2]uint32{r.uint32(), r.uint32()}
+		}
+		if r.optional() {
+			minSequence := int64(r.uint64())
+			conditions.MinSequence = &minSequence
+		}
+		conditions.MinSequenceAge = r.uint64()
+		conditions.MinSequenceLedgerGap = r.uint32()
+
+		// At most two extra signers
+		count := r.uint32()
+		if count > 2 {
+			r.fail("%d extra signers", count)
+		}
+		for i := uint32(0); i < count && r.err == nil; i++ {
+			conditions.ExtraSigners = append(conditions.ExtraSigners, r.signerKey())
+		}
+		return conditions
+	default:
+		r.fail("unsupported preconditions type %d", conditionType)
+		return nil
+	}
+}
+
+func (r *xdrReader) signerKey() string {
+	switch keyType := r.uint32(); keyType {
+	case signerKeyTypeEd25519:
+		return encodeAccountID(r.bytes(32))
+	case signerKeyTypePreAuthTx:
+		return encodeStrKey(preAuthTxVersionByte, r.bytes(32))
+	case signerKeyTypeHashX:
+		return encodeStrKey(hashXVersionByte, r.bytes(32))
+	case signerKeyTypeSignedPayload:
+		// The StrKey holds the key and payload as they are laid out in the XDR
+		start := r.raw
+		r.bytes(32)
+		r.opaque()
+		if r.err != nil {
+			return ""
+		}
+		return encodeStrKey(signedPayloadVersionByte, start[:len(start)-len(r.raw)])
+	default:
+		r.fail("unsupported signer key type %d", keyType)
+		return ""
+	}
+}
+
+func (r *xdrReader) fail(format string, args ...any) {
+	if r.err == nil {
+		r.err = fmt.Errorf(format, args...)
+	}
+}
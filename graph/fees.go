@@ -0,0 +1,97 @@
+package graph
+
+import (
+	"sort"
+
+	"github.com/owenjacob/hubblegraphql/graph/model"
+)
+
+// Projection of history_ledgers used to measure how full recent ledgers were
+type LedgerCapacity struct {
+	Sequence       int `db:"sequence"`
+	BaseFee        int `db:"base_fee"`
+	OperationCount int `db:"operation_count"`
+	MaxTxSetSize   int `db:"max_tx_set_size"`
+}
+
+// Projection of history_transactions holding what was bid and paid
+type TransactionFee struct {
+	FeeCharged           int64  `db:"fee_charged"`
+	MaxFee               int64  `db:"max_fee"`
+	NewMaxFee            int64  `db:"new_max_fee"`
+	OperationCount       int64  `db:"operation_count"`
+	InnerTransactionHash string `db:"inner_transaction_hash"`
+}
+
+// Fees are compared per operation. A fee bump pays for its own wrapper on top of the inner
+// transaction's operations and bids with the new max fee.
+func perOperationFees(transactions []TransactionFee) ([]int64, []int64) {
+	charged := make([]int64, 0, len(transactions))
+	bids := make([]int64, 0, len(transactions))
+	for i := range transactions {
+		operations := transactions[i].OperationCount
+		maxFee := transactions[i].MaxFee
+		if transactions[i].InnerTransactionHash != "" {
+			operations++
+			maxFee = transactions[i].NewMaxFee
+		}
+		if operations <= 0 {
+			continue
+		}
+		charged = append(charged, transactions[i].FeeCharged/operations)
+		bids = append(bids, maxFee/operations)
+	}
+	return charged, bids
+}
+
+// Nearest rank percentiles, falling back to the base fee when no transactions were found
+func feeDistribution(fees []int64, baseFee int) *model.FeeDistribution {
+	if len(fees) == 0 {
+		fee := int64(baseFee)
+		return &model.FeeDistribution{
+			Min: fee, Mode: fee, Max: fee,
+			P10: fee, P20: fee, P30: fee, P40: fee, P50: fee,
+			P60: fee, P70: fee, P80: fee, P90: fee, P95: fee, P99: fee,
+		}
+	}
+
+	sorted := append([]int64{}, fees...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	percentile := func(p int) int64 {
+		rank := (p*len(sorted) + 99) / 100
+		if rank < 1 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	}
+
+	// Sorted input means equal fees are adjacent, and the first longest run is the lowest mode
+	mode, modeCount, runCount := sorted[0], 0, 0
+	for i := range sorted {
+		if i > 0 && sorted[i] == sorted[i-1] {
+			runCount++
+		} else {
+			runCount = 1
+		}
+		if runCount > modeCount {
+			mode, modeCount = sorted[i], runCount
+		}
+	}
+
+	return &model.FeeDistribution{
+		Min:  sorted[0],
+		Mode: mode,
+		Max:  sorted[len(sorted)-1],
+		P10:  percentile(10),
+		P20:  percentile(20),
+		P30:  percentile(30),
+		P40:  percentile(40),
+		P50:  percentile(50),
+		P60:  percentile(60),
+		P70:  percentile(70),
+		P80:  percentile(80),
+		P90:  percentile(90),
+		P95:  percentile(95),
+		P99:  percentile(99),
+	}
+}
@@ -0,0 +1,267 @@
+package graph
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Keys of the fixture accounts. Only the keys are made up, the addresses are valid StrKeys.
+var (
+	alice  = encodeAccountID(bytes.Repeat([]byte{0xa1}, 32))
+	bob    = encodeAccountID(bytes.Repeat([]byte{0xb0}, 32))
+	issuer = encodeAccountID(bytes.Repeat([]byte{0x15}, 32))
+
+	usd = asset{Type: assetTypeCreditAlphanum4, Code: "USD", Issuer: issuer}
+	eur = asset{Type: assetTypeCreditAlphanum4, Code: "EUR", Issuer: issuer}
+)
+
+// Horizon's ids: the ledger sequence in the high 32 bits, then the transaction's application
+// order and the operation's index
+func ledgerID(sequence int) int64 {
+	return int64(sequence) << 32
+}
+
+func transactionID(sequence int, order int) int64 {
+	return ledgerID(sequence) | int64(order)<<12
+}
+
+func closedAt(sequence int) time.Time {
+	return time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC).Add(time.Duration(sequence-100) * 5 * time.Second)
+}
+
+func fixtureLedger(sequence int, transactions int, operations int) HistoryLedgers {
+	return HistoryLedgers{
+		Sequence:                   sequence,
+		LedgerHash:                 hashOf("ledger", sequence),
+		PreviousLedgerHash:         hashOf("ledger", sequence-1),
+		TransactionCount:           transactions,
+		OperationCount:             operations,
+		ClosedAt:                   closedAt(sequence),
+		CreatedAt:                  closedAt(sequence).Add(time.Second),
+		UpdatedAt:                  closedAt(sequence).Add(time.Second),
+		ID:                         ledgerID(sequence),
+		ImporterVersion:            15,
+		TotalCoins:                 1054439020873472865,
+		FeePool:                    18153860170384,
+		BaseFee:                    100,
+		BaseReserve:                5000000,
+		MaxTxSetSize:               10,
+		ProtocolVersion:            17,
+		LedgerHeader:               "AAAAEQ==",
+		SuccessfulTransactionCount: transactions,
+		FailedTransactionCount:     0,
+	}
+}
+
+// Just enough XDR to write the fixture envelopes
+type xdrWriter struct {
+	bytes.Buffer
+}
+
+func (w *xdrWriter) uint32(v uint32) *xdrWriter {
+	binary.Write(&w.Buffer, binary.BigEndian, v)
+	return w
+}
+
+func (w *xdrWriter) uint64(v uint64) *xdrWriter {
+	binary.Write(&w.Buffer, binary.BigEndian, v)
+	return w
+}
+
+func (w *xdrWriter) key(address string) *xdrWriter {
+	key, _ := decodeAccountID(address)
+	w.Write(key)
+	return w
+}
+
+// Envelopes are only written as far as the preconditions, which is all that is read of them
+func (w *xdrWriter) base64() string {
+	return base64.StdEncoding.EncodeToString(w.Bytes())
+}
+
+// Deterministic 64 character hex hashes
+func hashOf(kind string, n int) string {
+	hash := sha256.Sum256([]byte(kind + strconv.Itoa(n)))
+	return hex.EncodeToString(hash[:])
+}
+
+// Rows shared by the in-memory and the Postgres runs of the golden queries. JSON columns are
+// written the way Postgres prints jsonb, so both backends answer with the same bytes.
+func fixtures() *MemoryStore {
+	ledger103 := fixtureLedger(103, 2, 3)
+	ledger103.SuccessfulTransactionCount = 1
+	ledger103.FailedTransactionCount = 1
+
+	// Time bounds of 100 to 200
+	envelope1 := (&xdrWriter{}).uint32(envelopeTypeTx).uint32(0).key(alice).uint32(1000).uint64(4294967297).
+		uint32(preconditionsTime).uint64(100).uint64(200).base64()
+	// A fee bump paid by alice's muxed account, around a transaction with every other
+	// precondition: from ledger 100, a minimum sequence, age and gap, and two extra signers
+	envelope2 := (&xdrWriter{}).uint32(envelopeTypeTxFeeBump).uint32(0x100).uint64(7).key(alice).uint64(5000).
+		uint32(envelopeTypeTx).uint32(0).key(bob).uint32(100).uint64(8589934593).
+		uint32(preconditionsV2).uint32(0).uint32(1).uint32(100).uint32(0).uint32(1).uint64(8589934590).
+		uint64(3600).uint32(10).uint32(2).
+		uint32(signerKeyTypeEd25519).key(alice).
+		uint32(signerKeyTypeSignedPayload).key(issuer).uint32(5).uint32(0x68656c6c).uint32(0x6f000000).base64()
+	// A pre-protocol 13 envelope whose time bounds are both 0, so unbounded
+	envelope3 := (&xdrWriter{}).uint32(envelopeTypeTxV0).key(alice).uint32(300).uint64(4294967298).
+		uint32(1).uint64(0).uint64(0).base64()
+
+	tx1 := transactionID(103, 1)
+	tx2 := transactionID(103, 2)
+	tx3 := transactionID(104, 1)
+
+	return &MemoryStore{
+		HistoryLedgers: []HistoryLedgers{
+			fixtureLedger(100, 0, 0),
+			fixtureLedger(101, 0, 0),
+			fixtureLedger(102, 0, 0),
+			ledger103,
+			fixtureLedger(104, 1, 1),
+		},
+		HistoryTransactions: []HistoryTransactions{
+			{
+				TransactionHash:  hashOf("tx", 1),
+				LedgerSequence:   103,
+				ApplicationOrder: 1,
+				Account:          alice,
+				AccountSequence:  4294967297,
+				MaxFee:           1000,
+				OperationCount:   2,
+				CreatedAt:        closedAt(103).Add(time.Second),
+				UpdatedAt:        closedAt(103).Add(time.Second),
+				ID:               tx1,
+				TxEnvelope:       envelope1,
+				TxResult:         "AAAAAAAAAMgAAAAAAAAAAQ==",
+				TxMeta:           "AAAAAgAAAAI=",
+				TxFeeMeta:        "AAAAAgAAAAM=",
+				Signatures:       []string{"c2lnbmF0dXJlMQ=="},
+				MemoType:         "text",
+				Memo:             "rent",
+				TimeBounds:       "[100,200)",
+				Successful:       true,
+				FeeCharged:       200,
+				InnerSignatures:  []string{},
+			},
+			{
+				TransactionHash:      hashOf("tx", 2),
+				LedgerSequence:       103,
+				ApplicationOrder:     2,
+				Account:              bob,
+				AccountSequence:      8589934593,
+				MaxFee:               100,
+				OperationCount:       1,
+				CreatedAt:            closedAt(103).Add(time.Second),
+				UpdatedAt:            closedAt(103).Add(time.Second),
+				ID:                   tx2,
+				TxEnvelope:           envelope2,
+				TxResult:             "AAAAAAAAAZD/////",
+				TxMeta:               "AAAAAgAAAAQ=",
+				TxFeeMeta:            "AAAAAgAAAAU=",
+				Signatures:           []string{"c2lnbmF0dXJlMg=="},
+				MemoType:             "none",
+				Successful:           false,
+				FeeCharged:           400,
+				InnerTransactionHash: hashOf("inner", 2),
+				FeeAccount:           alice,
+				InnerSignatures:      []string{"aW5uZXIy"},
+				NewMaxFee:            5000,
+			},
+			{
+				TransactionHash:  hashOf("tx", 3),
+				LedgerSequence:   104,
+				ApplicationOrder: 1,
+				Account:          alice,
+				AccountSequence:  4294967298,
+				MaxFee:           300,
+				OperationCount:   1,
+				CreatedAt:        closedAt(104).Add(time.Second),
+				UpdatedAt:        closedAt(104).Add(time.Second),
+				ID:               tx3,
+				TxEnvelope:       envelope3,
+				TxResult:         "AAAAAAAAAGQAAAAAAAAAAQ==",
+				TxMeta:           "AAAAAgAAAAY=",
+				TxFeeMeta:        "AAAAAgAAAAc=",
+				Signatures:       []string{"c2lnbmF0dXJlMw==", "c2lnbmF0dXJlNA=="},
+				MemoType:         "none",
+				TimeBounds:       "[0,)",
+				Successful:       true,
+				FeeCharged:       100,
+				InnerSignatures:  []string{},
+			},
+		},
+		HistoryOperations: []HistoryOperations{
+			{ID: tx1 + 1, TransactionID: tx1, ApplicationOrder: 1, Type: 1, SourceAccount: alice,
+				Details: json.RawMessage(`{"to": "` + bob + `", "from": "` + alice + `", "amount": "10.0000000", "asset_type": "native"}`)},
+			{ID: tx1 + 2, TransactionID: tx1, ApplicationOrder: 2, Type: 6, SourceAccount: alice,
+				Details: json.RawMessage(`{"limit": "10000.0000000", "trustee": "` + issuer + `", "trustor": "` + alice + `", "asset_code": "EUR", "asset_type": "credit_alphanum4", "asset_issuer": "` + issuer + `"}`)},
+			{ID: tx2 + 1, TransactionID: tx2, ApplicationOrder: 1, Type: 10, SourceAccount: bob,
+				Details: json.RawMessage(`{"name": "config", "value": "aGVsbG8="}`)},
+			{ID: tx3 + 1, TransactionID: tx3, ApplicationOrder: 1, Type: 11, SourceAccount: alice},
+		},
+		Participants: map[string][]int64{
+			alice: {tx1, tx2, tx3},
+			bob:   {tx2},
+		},
+		Accounts: []Account{
+			{AccountID: alice, Balance: 10000000000, SellingLiabilities: 20000000, SeqNum: 4294967298,
+				Subentries: 5, HomeDomain: "example.com", MasterWeight: 2, Low: 1, Medium: 2, High: 3,
+				LastModified: 104, NumSponsoring: 2},
+			{AccountID: bob, Balance: 25000000, SeqNum: 8589934593, Subentries: 1, Flags: 3,
+				MasterWeight: 1, LastModified: 103, Sponsor: alice, NumSponsored: 1},
+			{AccountID: issuer, Balance: 500000000, SeqNum: 12884901889, InflationDest: alice, Flags: 1,
+				MasterWeight: 1, LastModified: 101},
+		},
+		AccountTrustLines: []TrustLine{
+			{LedgerKey: "tl-alice-usd", AccountID: alice, AssetType: assetTypeCreditAlphanum4, AssetIssuer: issuer,
+				AssetCode: "USD", Balance: 5000000000, TrustLineLimit: 100000000000, BuyingLiabilities: 10000000,
+				Flags: 1, LastModifiedLedger: 102},
+			{LedgerKey: "tl-alice-eur", AccountID: alice, AssetType: assetTypeCreditAlphanum4, AssetIssuer: issuer,
+				AssetCode: "EUR", Balance: 2000000000, TrustLineLimit: 100000000000, LastModifiedLedger: 103,
+				// Only authorized to maintain liabilities
+				Flags: 2},
+			// Shares of the native/EUR pool, which path finding has to skip
+			{LedgerKey: "tl-alice-pool", AccountID: alice, AssetType: assetTypePoolShare, Balance: 100000000,
+				TrustLineLimit: 9223372036854775807, Flags: 1, LastModifiedLedger: 104},
+			{LedgerKey: "tl-bob-usd", AccountID: bob, AssetType: assetTypeCreditAlphanum4, AssetIssuer: issuer,
+				AssetCode: "USD", Balance: 500000000, TrustLineLimit: 9223372036854775807,
+				LastModifiedLedger: 103, Sponsor: alice,
+				// Authorized, with clawback enabled
+				Flags: 5},
+		},
+		AccountSigners: []AccountSigner{
+			{AccountID: alice, Signer: alice, Weight: 2},
+			{AccountID: alice, Signer: bob, Weight: 1},
+			{AccountID: bob, Signer: bob, Weight: 1},
+		},
+		AccountData: []AccountData{
+			{LedgerKey: "data-alice-config", AccountID: alice, Name: "config", Value: "aGVsbG8=", LastModified: 103},
+			// Binary, so it has hex but no value
+			{LedgerKey: "data-alice-config_v2", AccountID: alice, Name: "config_v2", Value: "/wA=", LastModified: 103, Sponsor: bob},
+			// Only matches the config_ prefix if the underscore is taken as a wildcard
+			{LedgerKey: "data-alice-configxv3", AccountID: alice, Name: "configxv3", Value: "djM=", LastModified: 104},
+		},
+		ClaimableBalances: []ClaimableBalance{
+			{ID: "00000000" + hashOf("balance", 1), Sponsor: alice},
+		},
+		Offers: []Offer{
+			// Bob sells 100 USD for XLM at 2 XLM each
+			{SellerID: bob, OfferID: 1, SellingAsset: usd.xdr(), BuyingAsset: nativeAsset.xdr(), Amount: 1000000000,
+				Pricen: 2, Priced: 1, Price: 2, LastModifiedLedger: 103},
+			// The issuer sells 500 EUR for USD one to one
+			{SellerID: issuer, OfferID: 2, SellingAsset: eur.xdr(), BuyingAsset: usd.xdr(), Amount: 5000000000,
+				Pricen: 1, Priced: 1, Price: 1, LastModifiedLedger: 102},
+		},
+		Pools: []LiquidityPool{
+			{ID: hashOf("pool", 1), Type: 0, Fee: 30, TrustlineCount: 2, ShareCount: 1414213562,
+				AssetReserves:      json.RawMessage(`[{"asset": "native", "amount": "100.0000000", "reserve": 1000000000}, {"asset": "EUR:` + issuer + `", "amount": "200.0000000", "reserve": 2000000000}]`),
+				LastModifiedLedger: 104},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/introspection"
@@ -46,19 +47,35 @@ type DirectiveRoot struct {
 
 type ComplexityRoot struct {
 	Account struct {
-		Balances        func(childComplexity int) int
-		Data            func(childComplexity int, name *string) int
-		Flags           func(childComplexity int) int
-		HighThreshold   func(childComplexity int) int
-		HomeDomain      func(childComplexity int) int
-		ID              func(childComplexity int) int
-		LowThreshold    func(childComplexity int) int
-		MasterWeight    func(childComplexity int) int
-		MediumThreshold func(childComplexity int) int
-		NativeBalance   func(childComplexity int) int
-		Sequence        func(childComplexity int) int
-		Signers         func(childComplexity int) int
-		Transactions    func(childComplexity int, limit *int, order *model.Order, filterBy *model.FilterBy) int
+		AvailableNativeBalance func(childComplexity int) int
+		Balances               func(childComplexity int) int
+		Data                   func(childComplexity int, name *string, namePrefix *string) int
+		Flags                  func(childComplexity int) int
+		HighThreshold          func(childComplexity int) int
+		HomeDomain             func(childComplexity int) int
+		ID                     func(childComplexity int) int
+		InflationDestination   func(childComplexity int) int
+		LastModifiedLedger     func(childComplexity int) int
+		LowThreshold           func(childComplexity int) int
+		MasterWeight           func(childComplexity int) int
+		MediumThreshold        func(childComplexity int) int
+		MinimumBalance         func(childComplexity int) int
+		NativeBalance          func(childComplexity int) int
+		NumSponsored           func(childComplexity int) int
+		NumSponsoring          func(childComplexity int) int
+		Sequence               func(childComplexity int) int
+		Signers                func(childComplexity int) int
+		Sponsor                func(childComplexity int) int
+		SponsoredBy            func(childComplexity int, limit *int) int
+		Sponsoring             func(childComplexity int, limit *int) int
+		SubentryCount          func(childComplexity int) int
+		Transactions           func(childComplexity int, limit *int, order *model.Order, filterBy *model.FilterBy) int
+	}
+
+	Asset struct {
+		Code   func(childComplexity int) int
+		Issuer func(childComplexity int) int
+		Type   func(childComplexity int) int
 	}
 
 	Balance struct {
@@ -73,8 +90,48 @@ type ComplexityRoot struct {
 	}
 
 	Data struct {
-		Name  func(childComplexity int) int
-		Value func(childComplexity int) int
+		ByteLength         func(childComplexity int) int
+		Hex                func(childComplexity int) int
+		LastModifiedLedger func(childComplexity int) int
+		Name               func(childComplexity int) int
+		RawValue           func(childComplexity int) int
+		Sponsor            func(childComplexity int) int
+		Value              func(childComplexity int) int
+	}
+
+	FeeBumpInfo struct {
+		FeeAccount      func(childComplexity int) int
+		MaxFee          func(childComplexity int) int
+		Signatures      func(childComplexity int) int
+		TransactionHash func(childComplexity int) int
+	}
+
+	FeeDistribution struct {
+		Max  func(childComplexity int) int
+		Min  func(childComplexity int) int
+		Mode func(childComplexity int) int
+		P10  func(childComplexity int) int
+		P20  func(childComplexity int) int
+		P30  func(childComplexity int) int
+		P40  func(childComplexity int) int
+		P50  func(childComplexity int) int
+		P60  func(childComplexity int) int
+		P70  func(childComplexity int) int
+		P80  func(childComplexity int) int
+		P90  func(childComplexity int) int
+		P95  func(childComplexity int) int
+		P99  func(childComplexity int) int
+	}
+
+	FeeStats struct {
+		FeeCharged          func(childComplexity int) int
+		LastLedger          func(childComplexity int) int
+		LastLedgerBaseFee   func(childComplexity int) int
+		LedgerCapacityUsage func(childComplexity int) int
+		LedgerCount         func(childComplexity int) int
+		MaxFee              func(childComplexity int) int
+		MaxTxSetSize        func(childComplexity int) int
+		OperationCount      func(childComplexity int) int
 	}
 
 	Flags struct {
@@ -106,6 +163,11 @@ type ComplexityRoot struct {
 		UpdatedAt                  func(childComplexity int) int
 	}
 
+	LedgerBounds struct {
+		MaxLedger func(childComplexity int) int
+		MinLedger func(childComplexity int) int
+	}
+
 	Operation struct {
 		ApplicationOrder func(childComplexity int) int
 		Details          func(childComplexity int) int
@@ -115,10 +177,31 @@ type ComplexityRoot struct {
 		Type             func(childComplexity int) int
 	}
 
+	Path struct {
+		DestinationAmount func(childComplexity int) int
+		DestinationAsset  func(childComplexity int) int
+		Path              func(childComplexity int) int
+		SourceAmount      func(childComplexity int) int
+		SourceAsset       func(childComplexity int) int
+	}
+
+	Preconditions struct {
+		ExtraSigners                func(childComplexity int) int
+		LedgerBounds                func(childComplexity int) int
+		MinAccountSequence          func(childComplexity int) int
+		MinAccountSequenceAge       func(childComplexity int) int
+		MinAccountSequenceLedgerGap func(childComplexity int) int
+		TimeBounds                  func(childComplexity int) int
+	}
+
 	Query struct {
-		Account     func(childComplexity int, pubKey string) int
-		Ledger      func(childComplexity int, number *int, limit *int, order *model.Order, filterBy *model.FilterBy) int
-		Transaction func(childComplexity int, hash string) int
+		Account            func(childComplexity int, pubKey string) int
+		FeeStats           func(childComplexity int, ledgers *int) int
+		Ledger             func(childComplexity int, number *int, limit *int, order *model.Order, filterBy *model.FilterBy) int
+		Operation          func(childComplexity int, id string) int
+		StrictReceivePaths func(childComplexity int, sourceAssets []string, sourceAccount *string, destinationAsset string, destinationAmount string, maxHops *int, limit *int) int
+		StrictSendPaths    func(childComplexity int, sourceAsset string, sourceAmount string, destinationAssets []string, destinationAccount *string, maxHops *int, limit *int) int
+		Transaction        func(childComplexity int, hash string) int
 	}
 
 	Signer struct {
@@ -126,15 +209,31 @@ type ComplexityRoot struct {
 		Weight func(childComplexity int) int
 	}
 
+	SponsoredEntry struct {
+		Account func(childComplexity int) int
+		Key     func(childComplexity int) int
+		Sponsor func(childComplexity int) int
+		Type    func(childComplexity int) int
+	}
+
+	TimeBounds struct {
+		MaxTime     func(childComplexity int) int
+		MaxTimeUnix func(childComplexity int) int
+		MinTime     func(childComplexity int) int
+		MinTimeUnix func(childComplexity int) int
+	}
+
 	Transaction struct {
 		Account              func(childComplexity int) int
 		AccountSequence      func(childComplexity int) int
 		ApplicationOrder     func(childComplexity int) int
 		CreatedAt            func(childComplexity int) int
 		FeeAccount           func(childComplexity int) int
+		FeeBump              func(childComplexity int) int
 		FeeCharged           func(childComplexity int) int
 		ID                   func(childComplexity int) int
 		InnerSignatures      func(childComplexity int) int
+		InnerTransaction     func(childComplexity int) int
 		InnerTransactionHash func(childComplexity int) int
 		LedgerSequence       func(childComplexity int) int
 		MaxFee               func(childComplexity int) int
@@ -143,6 +242,7 @@ type ComplexityRoot struct {
 		NewMaxFee            func(childComplexity int) int
 		OperationCount       func(childComplexity int) int
 		Operations           func(childComplexity int, limit *int, order *model.Order) int
+		Preconditions        func(childComplexity int) int
 		Signatures           func(childComplexity int) int
 		Successful           func(childComplexity int) int
 		TimeBounds           func(childComplexity int) int
@@ -156,10 +256,15 @@ type ComplexityRoot struct {
 }
 
 type AccountResolver interface {
+	AvailableNativeBalance(ctx context.Context, obj *model.Account) (*string, error)
+	MinimumBalance(ctx context.Context, obj *model.Account) (*string, error)
+
 	Balances(ctx context.Context, obj *model.Account) ([]*model.Balance, error)
 	Signers(ctx context.Context, obj *model.Account) ([]*model.Signer, error)
-	Data(ctx context.Context, obj *model.Account, name *string) ([]*model.Data, error)
+	Data(ctx context.Context, obj *model.Account, name *string, namePrefix *string) ([]*model.Data, error)
 	Transactions(ctx context.Context, obj *model.Account, limit *int, order *model.Order, filterBy *model.FilterBy) ([]*model.Transaction, error)
+	Sponsoring(ctx context.Context, obj *model.Account, limit *int) ([]*model.SponsoredEntry, error)
+	SponsoredBy(ctx context.Context, obj *model.Account, limit *int) ([]*model.SponsoredEntry, error)
 }
 type LedgerResolver interface {
 	Transactions(ctx context.Context, obj *model.Ledger, limit *int, order *model.Order) ([]*model.Transaction, error)
@@ -167,9 +272,15 @@ type LedgerResolver interface {
 type QueryResolver interface {
 	Account(ctx context.Context, pubKey string) (*model.Account, error)
 	Transaction(ctx context.Context, hash string) (*model.Transaction, error)
+	Operation(ctx context.Context, id string) (*model.Operation, error)
 	Ledger(ctx context.Context, number *int, limit *int, order *model.Order, filterBy *model.FilterBy) ([]*model.Ledger, error)
+	StrictSendPaths(ctx context.Context, sourceAsset string, sourceAmount string, destinationAssets []string, destinationAccount *string, maxHops *int, limit *int) ([]*model.Path, error)
+	StrictReceivePaths(ctx context.Context, sourceAssets []string, sourceAccount *string, destinationAsset string, destinationAmount string, maxHops *int, limit *int) ([]*model.Path, error)
+	FeeStats(ctx context.Context, ledgers *int) (*model.FeeStats, error)
 }
 type TransactionResolver interface {
+	Preconditions(ctx context.Context, obj *model.Transaction) (*model.Preconditions, error)
+
 	Operations(ctx context.Context, obj *model.Transaction, limit *int, order *model.Order) ([]*model.Operation, error)
 }
 
@@ -188,6 +299,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "Account.availableNativeBalance":
+		if e.complexity.Account.AvailableNativeBalance == nil {
+			break
+		}
+
+		return e.complexity.Account.AvailableNativeBalance(childComplexity), true
+
 	case "Account.balances":
 		if e.complexity.Account.Balances == nil {
 			break
@@ -205,7 +323,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Account.Data(childComplexity, args["name"].(*string)), true
+		return e.complexity.Account.Data(childComplexity, args["name"].(*string), args["namePrefix"].(*string)), true
 
 	case "Account.flags":
 		if e.complexity.Account.Flags == nil {
@@ -235,6 +353,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Account.ID(childComplexity), true
 
+	case "Account.inflationDestination":
+		if e.complexity.Account.InflationDestination == nil {
+			break
+		}
+
+		return e.complexity.Account.InflationDestination(childComplexity), true
+
+	case "Account.lastModifiedLedger":
+		if e.complexity.Account.LastModifiedLedger == nil {
+			break
+		}
+
+		return e.complexity.Account.LastModifiedLedger(childComplexity), true
+
 	case "Account.lowThreshold":
 		if e.complexity.Account.LowThreshold == nil {
 			break
@@ -256,6 +388,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Account.MediumThreshold(childComplexity), true
 
+	case "Account.minimumBalance":
+		if e.complexity.Account.MinimumBalance == nil {
+			break
+		}
+
+		return e.complexity.Account.MinimumBalance(childComplexity), true
+
 	case "Account.nativeBalance":
 		if e.complexity.Account.NativeBalance == nil {
 			break
@@ -263,6 +402,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Account.NativeBalance(childComplexity), true
 
+	case "Account.numSponsored":
+		if e.complexity.Account.NumSponsored == nil {
+			break
+		}
+
+		return e.complexity.Account.NumSponsored(childComplexity), true
+
+	case "Account.numSponsoring":
+		if e.complexity.Account.NumSponsoring == nil {
+			break
+		}
+
+		return e.complexity.Account.NumSponsoring(childComplexity), true
+
 	case "Account.sequence":
 		if e.complexity.Account.Sequence == nil {
 			break
@@ -277,6 +430,44 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Account.Signers(childComplexity), true
 
+	case "Account.sponsor":
+		if e.complexity.Account.Sponsor == nil {
+			break
+		}
+
+		return e.complexity.Account.Sponsor(childComplexity), true
+
+	case "Account.sponsoredBy":
+		if e.complexity.Account.SponsoredBy == nil {
+			break
+		}
+
+		args, err := ec.field_Account_sponsoredBy_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Account.SponsoredBy(childComplexity, args["limit"].(*int)), true
+
+	case "Account.sponsoring":
+		if e.complexity.Account.Sponsoring == nil {
+			break
+		}
+
+		args, err := ec.field_Account_sponsoring_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Account.Sponsoring(childComplexity, args["limit"].(*int)), true
+
+	case "Account.subentryCount":
+		if e.complexity.Account.SubentryCount == nil {
+			break
+		}
+
+		return e.complexity.Account.SubentryCount(childComplexity), true
+
 	case "Account.transactions":
 		if e.complexity.Account.Transactions == nil {
 			break
@@ -289,6 +480,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Account.Transactions(childComplexity, args["limit"].(*int), args["order"].(*model.Order), args["filterBy"].(*model.FilterBy)), true
 
+	case "Asset.code":
+		if e.complexity.Asset.Code == nil {
+			break
+		}
+
+		return e.complexity.Asset.Code(childComplexity), true
+
+	case "Asset.issuer":
+		if e.complexity.Asset.Issuer == nil {
+			break
+		}
+
+		return e.complexity.Asset.Issuer(childComplexity), true
+
+	case "Asset.type":
+		if e.complexity.Asset.Type == nil {
+			break
+		}
+
+		return e.complexity.Asset.Type(childComplexity), true
+
 	case "Balance.assetCode":
 		if e.complexity.Balance.AssetCode == nil {
 			break
@@ -345,6 +557,27 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Balance.SellingLiabilities(childComplexity), true
 
+	case "Data.byteLength":
+		if e.complexity.Data.ByteLength == nil {
+			break
+		}
+
+		return e.complexity.Data.ByteLength(childComplexity), true
+
+	case "Data.hex":
+		if e.complexity.Data.Hex == nil {
+			break
+		}
+
+		return e.complexity.Data.Hex(childComplexity), true
+
+	case "Data.lastModifiedLedger":
+		if e.complexity.Data.LastModifiedLedger == nil {
+			break
+		}
+
+		return e.complexity.Data.LastModifiedLedger(childComplexity), true
+
 	case "Data.name":
 		if e.complexity.Data.Name == nil {
 			break
@@ -352,6 +585,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Data.Name(childComplexity), true
 
+	case "Data.rawValue":
+		if e.complexity.Data.RawValue == nil {
+			break
+		}
+
+		return e.complexity.Data.RawValue(childComplexity), true
+
+	case "Data.sponsor":
+		if e.complexity.Data.Sponsor == nil {
+			break
+		}
+
+		return e.complexity.Data.Sponsor(childComplexity), true
+
 	case "Data.value":
 		if e.complexity.Data.Value == nil {
 			break
@@ -359,6 +606,188 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Data.Value(childComplexity), true
 
+	case "FeeBumpInfo.feeAccount":
+		if e.complexity.FeeBumpInfo.FeeAccount == nil {
+			break
+		}
+
+		return e.complexity.FeeBumpInfo.FeeAccount(childComplexity), true
+
+	case "FeeBumpInfo.maxFee":
+		if e.complexity.FeeBumpInfo.MaxFee == nil {
+			break
+		}
+
+		return e.complexity.FeeBumpInfo.MaxFee(childComplexity), true
+
+	case "FeeBumpInfo.signatures":
+		if e.complexity.FeeBumpInfo.Signatures == nil {
+			break
+		}
+
+		return e.complexity.FeeBumpInfo.Signatures(childComplexity), true
+
+	case "FeeBumpInfo.transactionHash":
+		if e.complexity.FeeBumpInfo.TransactionHash == nil {
+			break
+		}
+
+		return e.complexity.FeeBumpInfo.TransactionHash(childComplexity), true
+
+	case "FeeDistribution.max":
+		if e.complexity.FeeDistribution.Max == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.Max(childComplexity), true
+
+	case "FeeDistribution.min":
+		if e.complexity.FeeDistribution.Min == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.Min(childComplexity), true
+
+	case "FeeDistribution.mode":
+		if e.complexity.FeeDistribution.Mode == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.Mode(childComplexity), true
+
+	case "FeeDistribution.p10":
+		if e.complexity.FeeDistribution.P10 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P10(childComplexity), true
+
+	case "FeeDistribution.p20":
+		if e.complexity.FeeDistribution.P20 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P20(childComplexity), true
+
+	case "FeeDistribution.p30":
+		if e.complexity.FeeDistribution.P30 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P30(childComplexity), true
+
+	case "FeeDistribution.p40":
+		if e.complexity.FeeDistribution.P40 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P40(childComplexity), true
+
+	case "FeeDistribution.p50":
+		if e.complexity.FeeDistribution.P50 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P50(childComplexity), true
+
+	case "FeeDistribution.p60":
+		if e.complexity.FeeDistribution.P60 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P60(childComplexity), true
+
+	case "FeeDistribution.p70":
+		if e.complexity.FeeDistribution.P70 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P70(childComplexity), true
+
+	case "FeeDistribution.p80":
+		if e.complexity.FeeDistribution.P80 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P80(childComplexity), true
+
+	case "FeeDistribution.p90":
+		if e.complexity.FeeDistribution.P90 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P90(childComplexity), true
+
+	case "FeeDistribution.p95":
+		if e.complexity.FeeDistribution.P95 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P95(childComplexity), true
+
+	case "FeeDistribution.p99":
+		if e.complexity.FeeDistribution.P99 == nil {
+			break
+		}
+
+		return e.complexity.FeeDistribution.P99(childComplexity), true
+
+	case "FeeStats.feeCharged":
+		if e.complexity.FeeStats.FeeCharged == nil {
+			break
+		}
+
+		return e.complexity.FeeStats.FeeCharged(childComplexity), true
+
+	case "FeeStats.lastLedger":
+		if e.complexity.FeeStats.LastLedger == nil {
+			break
+		}
+
+		return e.complexity.FeeStats.LastLedger(childComplexity), true
+
+	case "FeeStats.lastLedgerBaseFee":
+		if e.complexity.FeeStats.LastLedgerBaseFee == nil {
+			break
+		}
+
+		return e.complexity.FeeStats.LastLedgerBaseFee(childComplexity), true
+
+	case "FeeStats.ledgerCapacityUsage":
+		if e.complexity.FeeStats.LedgerCapacityUsage == nil {
+			break
+		}
+
+		return e.complexity.FeeStats.LedgerCapacityUsage(childComplexity), true
+
+	case "FeeStats.ledgerCount":
+		if e.complexity.FeeStats.LedgerCount == nil {
+			break
+		}
+
+		return e.complexity.FeeStats.LedgerCount(childComplexity), true
+
+	case "FeeStats.maxFee":
+		if e.complexity.FeeStats.MaxFee == nil {
+			break
+		}
+
+		return e.complexity.FeeStats.MaxFee(childComplexity), true
+
+	case "FeeStats.maxTxSetSize":
+		if e.complexity.FeeStats.MaxTxSetSize == nil {
+			break
+		}
+
+		return e.complexity.FeeStats.MaxTxSetSize(childComplexity), true
+
+	case "FeeStats.operationCount":
+		if e.complexity.FeeStats.OperationCount == nil {
+			break
+		}
+
+		return e.complexity.FeeStats.OperationCount(childComplexity), true
+
 	case "Flags.authImmutable":
 		if e.complexity.Flags.AuthImmutable == nil {
 			break
@@ -525,6 +954,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Ledger.UpdatedAt(childComplexity), true
 
+	case "LedgerBounds.maxLedger":
+		if e.complexity.LedgerBounds.MaxLedger == nil {
+			break
+		}
+
+		return e.complexity.LedgerBounds.MaxLedger(childComplexity), true
+
+	case "LedgerBounds.minLedger":
+		if e.complexity.LedgerBounds.MinLedger == nil {
+			break
+		}
+
+		return e.complexity.LedgerBounds.MinLedger(childComplexity), true
+
 	case "Operation.applicationOrder":
 		if e.complexity.Operation.ApplicationOrder == nil {
 			break
@@ -567,98 +1010,286 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Operation.Type(childComplexity), true
 
-	case "Query.account":
-		if e.complexity.Query.Account == nil {
+	case "Path.destinationAmount":
+		if e.complexity.Path.DestinationAmount == nil {
 			break
 		}
 
-		args, err := ec.field_Query_account_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Query.Account(childComplexity, args["pubKey"].(string)), true
+		return e.complexity.Path.DestinationAmount(childComplexity), true
 
-	case "Query.ledger":
-		if e.complexity.Query.Ledger == nil {
+	case "Path.destinationAsset":
+		if e.complexity.Path.DestinationAsset == nil {
 			break
 		}
 
-		args, err := ec.field_Query_ledger_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
-		}
-
-		return e.complexity.Query.Ledger(childComplexity, args["number"].(*int), args["limit"].(*int), args["order"].(*model.Order), args["filterBy"].(*model.FilterBy)), true
+		return e.complexity.Path.DestinationAsset(childComplexity), true
 
-	case "Query.transaction":
-		if e.complexity.Query.Transaction == nil {
+	case "Path.path":
+		if e.complexity.Path.Path == nil {
 			break
 		}
 
-		args, err := ec.field_Query_transaction_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Path.Path(childComplexity), true
+
+	case "Path.sourceAmount":
+		if e.complexity.Path.SourceAmount == nil {
+			break
 		}
 
-		return e.complexity.Query.Transaction(childComplexity, args["hash"].(string)), true
+		return e.complexity.Path.SourceAmount(childComplexity), true
 
-	case "Signer.key":
-		if e.complexity.Signer.Key == nil {
+	case "Path.sourceAsset":
+		if e.complexity.Path.SourceAsset == nil {
 			break
 		}
 
-		return e.complexity.Signer.Key(childComplexity), true
+		return e.complexity.Path.SourceAsset(childComplexity), true
 
-	case "Signer.weight":
-		if e.complexity.Signer.Weight == nil {
+	case "Preconditions.extraSigners":
+		if e.complexity.Preconditions.ExtraSigners == nil {
 			break
 		}
 
-		return e.complexity.Signer.Weight(childComplexity), true
+		return e.complexity.Preconditions.ExtraSigners(childComplexity), true
 
-	case "Transaction.account":
-		if e.complexity.Transaction.Account == nil {
+	case "Preconditions.ledgerBounds":
+		if e.complexity.Preconditions.LedgerBounds == nil {
 			break
 		}
 
-		return e.complexity.Transaction.Account(childComplexity), true
+		return e.complexity.Preconditions.LedgerBounds(childComplexity), true
 
-	case "Transaction.accountSequence":
-		if e.complexity.Transaction.AccountSequence == nil {
+	case "Preconditions.minAccountSequence":
+		if e.complexity.Preconditions.MinAccountSequence == nil {
 			break
 		}
 
-		return e.complexity.Transaction.AccountSequence(childComplexity), true
+		return e.complexity.Preconditions.MinAccountSequence(childComplexity), true
 
-	case "Transaction.applicationOrder":
-		if e.complexity.Transaction.ApplicationOrder == nil {
+	case "Preconditions.minAccountSequenceAge":
+		if e.complexity.Preconditions.MinAccountSequenceAge == nil {
 			break
 		}
 
-		return e.complexity.Transaction.ApplicationOrder(childComplexity), true
+		return e.complexity.Preconditions.MinAccountSequenceAge(childComplexity), true
 
-	case "Transaction.createdAt":
-		if e.complexity.Transaction.CreatedAt == nil {
+	case "Preconditions.minAccountSequenceLedgerGap":
+		if e.complexity.Preconditions.MinAccountSequenceLedgerGap == nil {
 			break
 		}
 
-		return e.complexity.Transaction.CreatedAt(childComplexity), true
+		return e.complexity.Preconditions.MinAccountSequenceLedgerGap(childComplexity), true
 
-	case "Transaction.feeAccount":
-		if e.complexity.Transaction.FeeAccount == nil {
+	case "Preconditions.timeBounds":
+		if e.complexity.Preconditions.TimeBounds == nil {
 			break
 		}
 
-		return e.complexity.Transaction.FeeAccount(childComplexity), true
+		return e.complexity.Preconditions.TimeBounds(childComplexity), true
 
-	case "Transaction.feeCharged":
-		if e.complexity.Transaction.FeeCharged == nil {
+	case "Query.account":
+		if e.complexity.Query.Account == nil {
 			break
 		}
 
-		return e.complexity.Transaction.FeeCharged(childComplexity), true
-
+		args, err := ec.field_Query_account_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Account(childComplexity, args["pubKey"].(string)), true
+
+	case "Query.feeStats":
+		if e.complexity.Query.FeeStats == nil {
+			break
+		}
+
+		args, err := ec.field_Query_feeStats_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FeeStats(childComplexity, args["ledgers"].(*int)), true
+
+	case "Query.ledger":
+		if e.complexity.Query.Ledger == nil {
+			break
+		}
+
+		args, err := ec.field_Query_ledger_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Ledger(childComplexity, args["number"].(*int), args["limit"].(*int), args["order"].(*model.Order), args["filterBy"].(*model.FilterBy)), true
+
+	case "Query.operation":
+		if e.complexity.Query.Operation == nil {
+			break
+		}
+
+		args, err := ec.field_Query_operation_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Operation(childComplexity, args["id"].(string)), true
+
+	case "Query.strictReceivePaths":
+		if e.complexity.Query.StrictReceivePaths == nil {
+			break
+		}
+
+		args, err := ec.field_Query_strictReceivePaths_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.StrictReceivePaths(childComplexity, args["sourceAssets"].([]string), args["sourceAccount"].(*string), args["destinationAsset"].(string), args["destinationAmount"].(string), args["maxHops"].(*int), args["limit"].(*int)), true
+
+	case "Query.strictSendPaths":
+		if e.complexity.Query.StrictSendPaths == nil {
+			break
+		}
+
+		args, err := ec.field_Query_strictSendPaths_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.StrictSendPaths(childComplexity, args["sourceAsset"].(string), args["sourceAmount"].(string), args["destinationAssets"].([]string), args["destinationAccount"].(*string), args["maxHops"].(*int), args["limit"].(*int)), true
+
+	case "Query.transaction":
+		if e.complexity.Query.Transaction == nil {
+			break
+		}
+
+		args, err := ec.field_Query_transaction_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Transaction(childComplexity, args["hash"].(string)), true
+
+	case "Signer.key":
+		if e.complexity.Signer.Key == nil {
+			break
+		}
+
+		return e.complexity.Signer.Key(childComplexity), true
+
+	case "Signer.weight":
+		if e.complexity.Signer.Weight == nil {
+			break
+		}
+
+		return e.complexity.Signer.Weight(childComplexity), true
+
+	case "SponsoredEntry.account":
+		if e.complexity.SponsoredEntry.Account == nil {
+			break
+		}
+
+		return e.complexity.SponsoredEntry.Account(childComplexity), true
+
+	case "SponsoredEntry.key":
+		if e.complexity.SponsoredEntry.Key == nil {
+			break
+		}
+
+		return e.complexity.SponsoredEntry.Key(childComplexity), true
+
+	case "SponsoredEntry.sponsor":
+		if e.complexity.SponsoredEntry.Sponsor == nil {
+			break
+		}
+
+		return e.complexity.SponsoredEntry.Sponsor(childComplexity), true
+
+	case "SponsoredEntry.type":
+		if e.complexity.SponsoredEntry.Type == nil {
+			break
+		}
+
+		return e.complexity.SponsoredEntry.Type(childComplexity), true
+
+	case "TimeBounds.maxTime":
+		if e.complexity.TimeBounds.MaxTime == nil {
+			break
+		}
+
+		return e.complexity.TimeBounds.MaxTime(childComplexity), true
+
+	case "TimeBounds.maxTimeUnix":
+		if e.complexity.TimeBounds.MaxTimeUnix == nil {
+			break
+		}
+
+		return e.complexity.TimeBounds.MaxTimeUnix(childComplexity), true
+
+	case "TimeBounds.minTime":
+		if e.complexity.TimeBounds.MinTime == nil {
+			break
+		}
+
+		return e.complexity.TimeBounds.MinTime(childComplexity), true
+
+	case "TimeBounds.minTimeUnix":
+		if e.complexity.TimeBounds.MinTimeUnix == nil {
+			break
+		}
+
+		return e.complexity.TimeBounds.MinTimeUnix(childComplexity), true
+
+	case "Transaction.account":
+		if e.complexity.Transaction.Account == nil {
+			break
+		}
+
+		return e.complexity.Transaction.Account(childComplexity), true
+
+	case "Transaction.accountSequence":
+		if e.complexity.Transaction.AccountSequence == nil {
+			break
+		}
+
+		return e.complexity.Transaction.AccountSequence(childComplexity), true
+
+	case "Transaction.applicationOrder":
+		if e.complexity.Transaction.ApplicationOrder == nil {
+			break
+		}
+
+		return e.complexity.Transaction.ApplicationOrder(childComplexity), true
+
+	case "Transaction.createdAt":
+		if e.complexity.Transaction.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Transaction.CreatedAt(childComplexity), true
+
+	case "Transaction.feeAccount":
+		if e.complexity.Transaction.FeeAccount == nil {
+			break
+		}
+
+		return e.complexity.Transaction.FeeAccount(childComplexity), true
+
+	case "Transaction.feeBump":
+		if e.complexity.Transaction.FeeBump == nil {
+			break
+		}
+
+		return e.complexity.Transaction.FeeBump(childComplexity), true
+
+	case "Transaction.feeCharged":
+		if e.complexity.Transaction.FeeCharged == nil {
+			break
+		}
+
+		return e.complexity.Transaction.FeeCharged(childComplexity), true
+
 	case "Transaction.id":
 		if e.complexity.Transaction.ID == nil {
 			break
@@ -673,6 +1304,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Transaction.InnerSignatures(childComplexity), true
 
+	case "Transaction.innerTransaction":
+		if e.complexity.Transaction.InnerTransaction == nil {
+			break
+		}
+
+		return e.complexity.Transaction.InnerTransaction(childComplexity), true
+
 	case "Transaction.innerTransactionHash":
 		if e.complexity.Transaction.InnerTransactionHash == nil {
 			break
@@ -734,6 +1372,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Transaction.Operations(childComplexity, args["limit"].(*int), args["order"].(*model.Order)), true
 
+	case "Transaction.preconditions":
+		if e.complexity.Transaction.Preconditions == nil {
+			break
+		}
+
+		return e.complexity.Transaction.Preconditions(childComplexity), true
+
 	case "Transaction.signatures":
 		if e.complexity.Transaction.Signatures == nil {
 			break
@@ -850,7 +1495,11 @@ var sources = []*ast.Source{
 	&ast.Source{Name: "graph/schema.graphqls", Input: `type Query {
   account(pubKey: String!): Account
   transaction(hash: String!): Transaction
+  operation(id: String!): Operation
   ledger(number: Int, limit: Int = 1, order: Order = "desc", filterBy: FilterBy): [Ledger]
+  strictSendPaths(sourceAsset: String!, sourceAmount: String!, destinationAssets: [String!], destinationAccount: String, maxHops: Int = 3, limit: Int = 10): [Path]
+  strictReceivePaths(sourceAssets: [String!], sourceAccount: String, destinationAsset: String!, destinationAmount: String!, maxHops: Int = 3, limit: Int = 10): [Path]
+  feeStats(ledgers: Int = 5): FeeStats
 }
 
 type Account {
@@ -858,17 +1507,31 @@ type Account {
   sequence: String!
   homeDomain: String!
   nativeBalance: String!
+  # Both null until a ledger has been ingested, since reserves are priced from the latest one
+  availableNativeBalance: String
+  minimumBalance: String
+  inflationDestination: String
+  subentryCount: Int!
+  lastModifiedLedger: Int!
   masterWeight: Int!
   lowThreshold: Int!
   mediumThreshold: Int!
   highThreshold: Int!
   flags: Flags!
+  sponsor: String
+  numSponsoring: Int!
+  numSponsored: Int!
   balances: [Balance]
   signers: [Signer]
-  data(name: String): [Data]
+  data(name: String, namePrefix: String): [Data]
   transactions(limit: Int = 10, order: Order = "desc", filterBy: FilterBy): [Transaction]
+  sponsoring(limit: Int = 10): [SponsoredEntry]
+  sponsoredBy(limit: Int = 10): [SponsoredEntry]
 }
 
+# A fee bump is seen from the outside, with the fee bump's hash, signatures and max fee, unless
+# it was looked up by its inner transaction's hash. innerTransaction is the view from the inside,
+# which has none of the fee bump fields.
 type Transaction {
   transactionHash: String!
   ledgerSequence: Int!
@@ -887,13 +1550,19 @@ type Transaction {
   signatures: [String!]!
   memoType: String!
   memo: String
-  timeBounds: [Int]
+  timeBounds: [Int] @deprecated(reason: "Use preconditions.timeBounds")
+  # Null when the transaction can be applied at any time
+  preconditions: Preconditions
   successful: Boolean
   feeCharged: String
-  innerTransactionHash: String
-  feeAccount: String
-  innerSignatures: [String]
-  newMaxFee: String
+  # Null for transactions that aren't fee bumps, and on the inner view of one
+  feeBump: FeeBumpInfo
+  innerTransaction: Transaction
+  innerTransactionHash: String @deprecated(reason: "Use innerTransaction.transactionHash")
+  feeAccount: String @deprecated(reason: "Use feeBump.feeAccount")
+  innerSignatures: [String] @deprecated(reason: "Use innerTransaction.signatures")
+  # In stroops, where feeBump.maxFee is in XLM like the other amounts
+  newMaxFee: String @deprecated(reason: "Use feeBump.maxFee, which is in XLM rather than stroops")
   operations(limit: Int = 10, order: Order = "desc"): [Operation]
 }
 
@@ -946,6 +1615,18 @@ type Signer {
 type Data {
   name: String
   value: String
+  rawValue: String!
+  hex: String
+  byteLength: Int
+  lastModifiedLedger: Int!
+  sponsor: String
+}
+
+type SponsoredEntry {
+  type: SponsoredEntryType!
+  account: String
+  sponsor: String!
+  key: String!
 }
 
 # Transaction sub objects
@@ -958,12 +1639,108 @@ type Operation {
   sourceAccount: String!
 }
 
+type FeeBumpInfo {
+  transactionHash: String!
+  # Account that paid the fee
+  feeAccount: String!
+  maxFee: String!
+  signatures: [String!]!
+}
+
+# When a transaction is valid. Conditions that aren't set are null.
+type Preconditions {
+  timeBounds: TimeBounds
+  ledgerBounds: LedgerBounds
+  minAccountSequence: Int64
+  # Seconds
+  minAccountSequenceAge: Int64
+  minAccountSequenceLedgerGap: Int
+  extraSigners: [String!]!
+}
+
+# Either end is null when unbounded. The unix times are exact even past the range of DateTime.
+type TimeBounds {
+  minTime: DateTime
+  maxTime: DateTime
+  minTimeUnix: Int64
+  maxTimeUnix: Int64
+}
+
+type LedgerBounds {
+  minLedger: Int!
+  # Null when unbounded
+  maxLedger: Int
+}
+
+# Path finding objects
+type Path {
+  sourceAsset: Asset!
+  sourceAmount: String!
+  destinationAsset: Asset!
+  destinationAmount: String!
+  path: [Asset!]!
+}
+
+type Asset {
+  type: AssetType!
+  code: String
+  issuer: String
+}
+
+# Fee statistics objects
+type FeeStats {
+  lastLedger: Int!
+  lastLedgerBaseFee: Int!
+  ledgerCount: Int!
+  ledgerCapacityUsage: Float!
+  operationCount: Int!
+  maxTxSetSize: Int!
+  feeCharged: FeeDistribution!
+  maxFee: FeeDistribution!
+}
+
+type FeeDistribution {
+  min: Int64!
+  mode: Int64!
+  max: Int64!
+  p10: Int64!
+  p20: Int64!
+  p30: Int64!
+  p40: Int64!
+  p50: Int64!
+  p60: Int64!
+  p70: Int64!
+  p80: Int64!
+  p90: Int64!
+  p95: Int64!
+  p99: Int64!
+}
+
 # Enums and other types
+# RFC 3339 timestamp
+scalar DateTime
+# 64 bit integer, written as a string
+scalar Int64
+
 enum Order {
   asc
   desc
 }
 
+enum AssetType {
+  native
+  credit_alphanum4
+  credit_alphanum12
+}
+
+enum SponsoredEntryType {
+  account
+  trustline
+  data
+  signer
+  claimableBalance
+}
+
 input FilterBy {
   account: AccountFilter
   date: DateFilter
@@ -1008,6 +1785,42 @@ func (ec *executionContext) field_Account_data_args(ctx context.Context, rawArgs
 		}
 	}
 	args["name"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["namePrefix"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["namePrefix"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Account_sponsoredBy_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Account_sponsoring_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
 	return args, nil
 }
 
@@ -1091,6 +1904,20 @@ func (ec *executionContext) field_Query_account_args(ctx context.Context, rawArg
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_feeStats_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["ledgers"]; ok {
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["ledgers"] = arg0
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_ledger_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -1129,57 +1956,179 @@ func (ec *executionContext) field_Query_ledger_args(ctx context.Context, rawArgs
 	return args, nil
 }
 
-func (ec *executionContext) field_Query_transaction_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_operation_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 string
-	if tmp, ok := rawArgs["hash"]; ok {
+	if tmp, ok := rawArgs["id"]; ok {
 		arg0, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["hash"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
 
-func (ec *executionContext) field_Transaction_operations_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_strictReceivePaths_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	var arg0 *int
-	if tmp, ok := rawArgs["limit"]; ok {
-		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+	var arg0 []string
+	if tmp, ok := rawArgs["sourceAssets"]; ok {
+		arg0, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["limit"] = arg0
-	var arg1 *model.Order
-	if tmp, ok := rawArgs["order"]; ok {
-		arg1, err = ec.unmarshalOOrder2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐOrder(ctx, tmp)
+	args["sourceAssets"] = arg0
+	var arg1 *string
+	if tmp, ok := rawArgs["sourceAccount"]; ok {
+		arg1, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["order"] = arg1
-	return args, nil
-}
-
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	var arg0 bool
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+	args["sourceAccount"] = arg1
+	var arg2 string
+	if tmp, ok := rawArgs["destinationAsset"]; ok {
+		arg2, err = ec.unmarshalNString2string(ctx, tmp)
 		if err != nil {
 			return nil, err
 		}
 	}
-	args["includeDeprecated"] = arg0
-	return args, nil
-}
-
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	args["destinationAsset"] = arg2
+	var arg3 string
+	if tmp, ok := rawArgs["destinationAmount"]; ok {
+		arg3, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["destinationAmount"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["maxHops"]; ok {
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["maxHops"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_strictSendPaths_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["sourceAsset"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sourceAsset"] = arg0
+	var arg1 string
+	if tmp, ok := rawArgs["sourceAmount"]; ok {
+		arg1, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["sourceAmount"] = arg1
+	var arg2 []string
+	if tmp, ok := rawArgs["destinationAssets"]; ok {
+		arg2, err = ec.unmarshalOString2ᚕstringᚄ(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["destinationAssets"] = arg2
+	var arg3 *string
+	if tmp, ok := rawArgs["destinationAccount"]; ok {
+		arg3, err = ec.unmarshalOString2ᚖstring(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["destinationAccount"] = arg3
+	var arg4 *int
+	if tmp, ok := rawArgs["maxHops"]; ok {
+		arg4, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["maxHops"] = arg4
+	var arg5 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg5, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_transaction_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 string
+	if tmp, ok := rawArgs["hash"]; ok {
+		arg0, err = ec.unmarshalNString2string(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["hash"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field_Transaction_operations_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 *int
+	if tmp, ok := rawArgs["limit"]; ok {
+		arg0, err = ec.unmarshalOInt2ᚖint(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["limit"] = arg0
+	var arg1 *model.Order
+	if tmp, ok := rawArgs["order"]; ok {
+		arg1, err = ec.unmarshalOOrder2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐOrder(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["order"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	var arg0 bool
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		arg0, err = ec.unmarshalOBoolean2bool(ctx, tmp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
 	var arg0 bool
@@ -1277,7 +2226,2330 @@ func (ec *executionContext) _Account_homeDomain(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HomeDomain, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_nativeBalance(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NativeBalance, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_availableNativeBalance(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Account().AvailableNativeBalance(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_minimumBalance(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Account().MinimumBalance(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_inflationDestination(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InflationDestination, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_subentryCount(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubentryCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_lastModifiedLedger(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastModifiedLedger, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_masterWeight(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MasterWeight, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_lowThreshold(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LowThreshold, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_mediumThreshold(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MediumThreshold, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_highThreshold(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.HighThreshold, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_flags(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Flags, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.Flags)
+	fc.Result = res
+	return ec.marshalNFlags2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFlags(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_sponsor(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Sponsor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_numSponsoring(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumSponsoring, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_numSponsored(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.NumSponsored, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_balances(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Account().Balances(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Balance)
+	fc.Result = res
+	return ec.marshalOBalance2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐBalance(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_signers(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Account().Signers(rctx, obj)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Signer)
+	fc.Result = res
+	return ec.marshalOSigner2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSigner(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_data(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Account_data_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Account().Data(rctx, obj, args["name"].(*string), args["namePrefix"].(*string))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Data)
+	fc.Result = res
+	return ec.marshalOData2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐData(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_transactions(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Account_transactions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Account().Transactions(rctx, obj, args["limit"].(*int), args["order"].(*model.Order), args["filterBy"].(*model.FilterBy))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Transaction)
+	fc.Result = res
+	return ec.marshalOTransaction2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_sponsoring(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Account_sponsoring_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Account().Sponsoring(rctx, obj, args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.SponsoredEntry)
+	fc.Result = res
+	return ec.marshalOSponsoredEntry2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSponsoredEntry(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Account_sponsoredBy(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Account",
+		Field:    field,
+		Args:     nil,
+		IsMethod: true,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Account_sponsoredBy_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Account().SponsoredBy(rctx, obj, args["limit"].(*int))
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.SponsoredEntry)
+	fc.Result = res
+	return ec.marshalOSponsoredEntry2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSponsoredEntry(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Asset_type(ctx context.Context, field graphql.CollectedField, obj *model.Asset) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Asset",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.AssetType)
+	fc.Result = res
+	return ec.marshalNAssetType2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAssetType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Asset_code(ctx context.Context, field graphql.CollectedField, obj *model.Asset) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Asset",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Code, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Asset_issuer(ctx context.Context, field graphql.CollectedField, obj *model.Asset) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Asset",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Issuer, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Balance_balance(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Balance",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Balance, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Balance_buyingLiabilities(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Balance",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.BuyingLiabilities, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Balance_sellingLiabilities(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Balance",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SellingLiabilities, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Balance_limit(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Balance",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Limit, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Balance_lastModifiedLedger(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Balance",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastModifiedLedger, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Balance_isAuthorized(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Balance",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsAuthorized, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Balance_assetCode(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Balance",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AssetCode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Balance_assetIssuer(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Balance",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AssetIssuer, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Data_name(ctx context.Context, field graphql.CollectedField, obj *model.Data) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Data",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Data_value(ctx context.Context, field graphql.CollectedField, obj *model.Data) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Data",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Value, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Data_rawValue(ctx context.Context, field graphql.CollectedField, obj *model.Data) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Data",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RawValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Data_hex(ctx context.Context, field graphql.CollectedField, obj *model.Data) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Data",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Hex, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Data_byteLength(ctx context.Context, field graphql.CollectedField, obj *model.Data) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Data",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ByteLength, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*int)
+	fc.Result = res
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Data_lastModifiedLedger(ctx context.Context, field graphql.CollectedField, obj *model.Data) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Data",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastModifiedLedger, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Data_sponsor(ctx context.Context, field graphql.CollectedField, obj *model.Data) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Data",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Sponsor, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeBumpInfo_transactionHash(ctx context.Context, field graphql.CollectedField, obj *model.FeeBumpInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeBumpInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TransactionHash, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeBumpInfo_feeAccount(ctx context.Context, field graphql.CollectedField, obj *model.FeeBumpInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeBumpInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FeeAccount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeBumpInfo_maxFee(ctx context.Context, field graphql.CollectedField, obj *model.FeeBumpInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeBumpInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxFee, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeBumpInfo_signatures(ctx context.Context, field graphql.CollectedField, obj *model.FeeBumpInfo) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeBumpInfo",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Signatures, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_min(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Min, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_mode(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Mode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_max(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Max, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p10(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P10, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p20(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P20, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p30(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P30, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p40(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P40, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p50(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P50, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p60(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P60, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p70(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P70, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p80(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P80, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p90(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P90, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p95(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P95, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeDistribution_p99(ctx context.Context, field graphql.CollectedField, obj *model.FeeDistribution) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeDistribution",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.P99, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int64)
+	fc.Result = res
+	return ec.marshalNInt642int64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeStats_lastLedger(ctx context.Context, field graphql.CollectedField, obj *model.FeeStats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeStats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastLedger, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeStats_lastLedgerBaseFee(ctx context.Context, field graphql.CollectedField, obj *model.FeeStats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeStats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LastLedgerBaseFee, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeStats_ledgerCount(ctx context.Context, field graphql.CollectedField, obj *model.FeeStats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeStats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LedgerCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeStats_ledgerCapacityUsage(ctx context.Context, field graphql.CollectedField, obj *model.FeeStats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeStats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.LedgerCapacityUsage, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(float64)
+	fc.Result = res
+	return ec.marshalNFloat2float64(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeStats_operationCount(ctx context.Context, field graphql.CollectedField, obj *model.FeeStats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeStats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OperationCount, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeStats_maxTxSetSize(ctx context.Context, field graphql.CollectedField, obj *model.FeeStats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeStats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxTxSetSize, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeStats_feeCharged(ctx context.Context, field graphql.CollectedField, obj *model.FeeStats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeStats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.FeeCharged, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.FeeDistribution)
+	fc.Result = res
+	return ec.marshalNFeeDistribution2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFeeDistribution(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _FeeStats_maxFee(ctx context.Context, field graphql.CollectedField, obj *model.FeeStats) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "FeeStats",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MaxFee, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.FeeDistribution)
+	fc.Result = res
+	return ec.marshalNFeeDistribution2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFeeDistribution(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Flags_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.Flags) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Flags",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuthRequired, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Flags_authRevocable(ctx context.Context, field graphql.CollectedField, obj *model.Flags) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Flags",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuthRevocable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Flags_authImmutable(ctx context.Context, field graphql.CollectedField, obj *model.Flags) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Flags",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AuthImmutable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Ledger_sequence(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Ledger",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Sequence, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Ledger_ledgerHash(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1286,7 +4558,7 @@ func (ec *executionContext) _Account_homeDomain(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HomeDomain, nil
+		return obj.LedgerHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1303,7 +4575,7 @@ func (ec *executionContext) _Account_homeDomain(ctx context.Context, field graph
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Account_nativeBalance(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_previousLedgerHash(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1311,7 +4583,7 @@ func (ec *executionContext) _Account_nativeBalance(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1320,24 +4592,21 @@ func (ec *executionContext) _Account_nativeBalance(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.NativeBalance, nil
+		return obj.PreviousLedgerHash, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Account_masterWeight(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_transactionCount(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1345,7 +4614,7 @@ func (ec *executionContext) _Account_masterWeight(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1354,7 +4623,7 @@ func (ec *executionContext) _Account_masterWeight(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MasterWeight, nil
+		return obj.TransactionCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1371,7 +4640,7 @@ func (ec *executionContext) _Account_masterWeight(ctx context.Context, field gra
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Account_lowThreshold(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_operationCount(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1379,7 +4648,7 @@ func (ec *executionContext) _Account_lowThreshold(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1388,7 +4657,7 @@ func (ec *executionContext) _Account_lowThreshold(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LowThreshold, nil
+		return obj.OperationCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1405,7 +4674,7 @@ func (ec *executionContext) _Account_lowThreshold(ctx context.Context, field gra
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Account_mediumThreshold(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_closedAt(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1413,7 +4682,7 @@ func (ec *executionContext) _Account_mediumThreshold(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1422,7 +4691,7 @@ func (ec *executionContext) _Account_mediumThreshold(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MediumThreshold, nil
+		return obj.ClosedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1434,12 +4703,12 @@ func (ec *executionContext) _Account_mediumThreshold(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Account_highThreshold(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1447,7 +4716,7 @@ func (ec *executionContext) _Account_highThreshold(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1456,24 +4725,21 @@ func (ec *executionContext) _Account_highThreshold(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.HighThreshold, nil
+		return obj.CreatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Account_flags(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1481,7 +4747,7 @@ func (ec *executionContext) _Account_flags(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1490,24 +4756,21 @@ func (ec *executionContext) _Account_flags(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Flags, nil
+		return obj.UpdatedAt, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Flags)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNFlags2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFlags(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Account_balances(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_id(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1515,16 +4778,16 @@ func (ec *executionContext) _Account_balances(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Account().Balances(rctx, obj)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1533,12 +4796,12 @@ func (ec *executionContext) _Account_balances(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Balance)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOBalance2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐBalance(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Account_signers(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_importerVersion(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1546,30 +4809,33 @@ func (ec *executionContext) _Account_signers(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Account().Signers(rctx, obj)
+		return obj.ImporterVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Signer)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOSigner2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSigner(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Account_data(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_totalCoins(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1577,37 +4843,33 @@ func (ec *executionContext) _Account_data(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Account_data_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Account().Data(rctx, obj, args["name"].(*string))
+		return obj.TotalCoins, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Data)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOData2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐData(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Account_transactions(ctx context.Context, field graphql.CollectedField, obj *model.Account) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_feePool(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1615,37 +4877,33 @@ func (ec *executionContext) _Account_transactions(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Account",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Account_transactions_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Account().Transactions(rctx, obj, args["limit"].(*int), args["order"].(*model.Order), args["filterBy"].(*model.FilterBy))
+		return obj.FeePool, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Transaction)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTransaction2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Balance_balance(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_baseFee(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1653,7 +4911,7 @@ func (ec *executionContext) _Balance_balance(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Balance",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1662,7 +4920,7 @@ func (ec *executionContext) _Balance_balance(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Balance, nil
+		return obj.BaseFee, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1674,12 +4932,12 @@ func (ec *executionContext) _Balance_balance(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Balance_buyingLiabilities(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_baseReserve(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1687,7 +4945,7 @@ func (ec *executionContext) _Balance_buyingLiabilities(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Balance",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1696,7 +4954,7 @@ func (ec *executionContext) _Balance_buyingLiabilities(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BuyingLiabilities, nil
+		return obj.BaseReserve, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1708,12 +4966,12 @@ func (ec *executionContext) _Balance_buyingLiabilities(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Balance_sellingLiabilities(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_maxTxSetSize(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1721,7 +4979,7 @@ func (ec *executionContext) _Balance_sellingLiabilities(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Balance",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1730,7 +4988,7 @@ func (ec *executionContext) _Balance_sellingLiabilities(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SellingLiabilities, nil
+		return obj.MaxTxSetSize, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1742,12 +5000,12 @@ func (ec *executionContext) _Balance_sellingLiabilities(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Balance_limit(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_protocolVersion(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1755,7 +5013,7 @@ func (ec *executionContext) _Balance_limit(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Balance",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1764,7 +5022,7 @@ func (ec *executionContext) _Balance_limit(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Limit, nil
+		return obj.ProtocolVersion, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1776,12 +5034,12 @@ func (ec *executionContext) _Balance_limit(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Balance_lastModifiedLedger(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_ledgerHeader(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1789,7 +5047,7 @@ func (ec *executionContext) _Balance_lastModifiedLedger(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Balance",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1798,24 +5056,21 @@ func (ec *executionContext) _Balance_lastModifiedLedger(ctx context.Context, fie
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LastModifiedLedger, nil
+		return obj.LedgerHeader, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Balance_isAuthorized(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_successfulTransactionCount(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1823,7 +5078,7 @@ func (ec *executionContext) _Balance_isAuthorized(ctx context.Context, field gra
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Balance",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1832,24 +5087,21 @@ func (ec *executionContext) _Balance_isAuthorized(ctx context.Context, field gra
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsAuthorized, nil
+		return obj.SuccessfulTransactionCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Balance_assetCode(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_failedTransactionCount(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1857,7 +5109,7 @@ func (ec *executionContext) _Balance_assetCode(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Balance",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1866,24 +5118,21 @@ func (ec *executionContext) _Balance_assetCode(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AssetCode, nil
+		return obj.FailedTransactionCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Balance_assetIssuer(ctx context.Context, field graphql.CollectedField, obj *model.Balance) (ret graphql.Marshaler) {
+func (ec *executionContext) _Ledger_transactions(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1891,33 +5140,37 @@ func (ec *executionContext) _Balance_assetIssuer(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Balance",
+		Object:   "Ledger",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Ledger_transactions_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AssetIssuer, nil
+		return ec.resolvers.Ledger().Transactions(rctx, obj, args["limit"].(*int), args["order"].(*model.Order))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.Transaction)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOTransaction2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Data_name(ctx context.Context, field graphql.CollectedField, obj *model.Data) (ret graphql.Marshaler) {
+func (ec *executionContext) _LedgerBounds_minLedger(ctx context.Context, field graphql.CollectedField, obj *model.LedgerBounds) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1925,7 +5178,7 @@ func (ec *executionContext) _Data_name(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Data",
+		Object:   "LedgerBounds",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1934,21 +5187,24 @@ func (ec *executionContext) _Data_name(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.MinLedger, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Data_value(ctx context.Context, field graphql.CollectedField, obj *model.Data) (ret graphql.Marshaler) {
+func (ec *executionContext) _LedgerBounds_maxLedger(ctx context.Context, field graphql.CollectedField, obj *model.LedgerBounds) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1956,7 +5212,7 @@ func (ec *executionContext) _Data_value(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Data",
+		Object:   "LedgerBounds",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1965,7 +5221,7 @@ func (ec *executionContext) _Data_value(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return obj.MaxLedger, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -1974,12 +5230,12 @@ func (ec *executionContext) _Data_value(ctx context.Context, field graphql.Colle
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flags_authRequired(ctx context.Context, field graphql.CollectedField, obj *model.Flags) (ret graphql.Marshaler) {
+func (ec *executionContext) _Operation_id(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -1987,7 +5243,7 @@ func (ec *executionContext) _Flags_authRequired(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Flags",
+		Object:   "Operation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -1996,7 +5252,7 @@ func (ec *executionContext) _Flags_authRequired(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AuthRequired, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2008,12 +5264,12 @@ func (ec *executionContext) _Flags_authRequired(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flags_authRevocable(ctx context.Context, field graphql.CollectedField, obj *model.Flags) (ret graphql.Marshaler) {
+func (ec *executionContext) _Operation_transactionID(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2021,7 +5277,7 @@ func (ec *executionContext) _Flags_authRevocable(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Flags",
+		Object:   "Operation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2030,7 +5286,7 @@ func (ec *executionContext) _Flags_authRevocable(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AuthRevocable, nil
+		return obj.TransactionID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2042,12 +5298,12 @@ func (ec *executionContext) _Flags_authRevocable(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Flags_authImmutable(ctx context.Context, field graphql.CollectedField, obj *model.Flags) (ret graphql.Marshaler) {
+func (ec *executionContext) _Operation_applicationOrder(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2055,7 +5311,7 @@ func (ec *executionContext) _Flags_authImmutable(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Flags",
+		Object:   "Operation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2064,7 +5320,7 @@ func (ec *executionContext) _Flags_authImmutable(ctx context.Context, field grap
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.AuthImmutable, nil
+		return obj.ApplicationOrder, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2076,12 +5332,12 @@ func (ec *executionContext) _Flags_authImmutable(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_sequence(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Operation_type(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2089,7 +5345,7 @@ func (ec *executionContext) _Ledger_sequence(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Operation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2098,7 +5354,7 @@ func (ec *executionContext) _Ledger_sequence(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Sequence, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2115,7 +5371,7 @@ func (ec *executionContext) _Ledger_sequence(ctx context.Context, field graphql.
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_ledgerHash(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Operation_details(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2123,7 +5379,7 @@ func (ec *executionContext) _Ledger_ledgerHash(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Operation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2132,24 +5388,21 @@ func (ec *executionContext) _Ledger_ledgerHash(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LedgerHash, nil
+		return obj.Details, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_previousLedgerHash(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Operation_sourceAccount(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2157,7 +5410,7 @@ func (ec *executionContext) _Ledger_previousLedgerHash(ctx context.Context, fiel
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Operation",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2166,21 +5419,24 @@ func (ec *executionContext) _Ledger_previousLedgerHash(ctx context.Context, fiel
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PreviousLedgerHash, nil
+		return obj.SourceAccount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_transactionCount(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Path_sourceAsset(ctx context.Context, field graphql.CollectedField, obj *model.Path) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2188,7 +5444,7 @@ func (ec *executionContext) _Ledger_transactionCount(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Path",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2197,7 +5453,7 @@ func (ec *executionContext) _Ledger_transactionCount(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TransactionCount, nil
+		return obj.SourceAsset, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2209,12 +5465,12 @@ func (ec *executionContext) _Ledger_transactionCount(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Asset)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNAsset2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAsset(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_operationCount(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Path_sourceAmount(ctx context.Context, field graphql.CollectedField, obj *model.Path) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2222,7 +5478,7 @@ func (ec *executionContext) _Ledger_operationCount(ctx context.Context, field gr
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Path",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2231,7 +5487,7 @@ func (ec *executionContext) _Ledger_operationCount(ctx context.Context, field gr
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OperationCount, nil
+		return obj.SourceAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2243,12 +5499,12 @@ func (ec *executionContext) _Ledger_operationCount(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_closedAt(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Path_destinationAsset(ctx context.Context, field graphql.CollectedField, obj *model.Path) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2256,7 +5512,7 @@ func (ec *executionContext) _Ledger_closedAt(ctx context.Context, field graphql.
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Path",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2265,7 +5521,7 @@ func (ec *executionContext) _Ledger_closedAt(ctx context.Context, field graphql.
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ClosedAt, nil
+		return obj.DestinationAsset, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2277,12 +5533,12 @@ func (ec *executionContext) _Ledger_closedAt(ctx context.Context, field graphql.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Asset)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNAsset2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAsset(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Path_destinationAmount(ctx context.Context, field graphql.CollectedField, obj *model.Path) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2290,7 +5546,7 @@ func (ec *executionContext) _Ledger_createdAt(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Path",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2299,21 +5555,24 @@ func (ec *executionContext) _Ledger_createdAt(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.CreatedAt, nil
+		return obj.DestinationAmount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Path_path(ctx context.Context, field graphql.CollectedField, obj *model.Path) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2321,7 +5580,7 @@ func (ec *executionContext) _Ledger_updatedAt(ctx context.Context, field graphql
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Path",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2330,21 +5589,24 @@ func (ec *executionContext) _Ledger_updatedAt(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.UpdatedAt, nil
+		return obj.Path, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.Asset)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNAsset2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAssetᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_id(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Preconditions_timeBounds(ctx context.Context, field graphql.CollectedField, obj *model.Preconditions) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2352,7 +5614,7 @@ func (ec *executionContext) _Ledger_id(ctx context.Context, field graphql.Collec
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Preconditions",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2361,7 +5623,7 @@ func (ec *executionContext) _Ledger_id(ctx context.Context, field graphql.Collec
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.TimeBounds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2370,12 +5632,12 @@ func (ec *executionContext) _Ledger_id(ctx context.Context, field graphql.Collec
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.(*model.TimeBounds)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOTimeBounds2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTimeBounds(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_importerVersion(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Preconditions_ledgerBounds(ctx context.Context, field graphql.CollectedField, obj *model.Preconditions) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2383,7 +5645,7 @@ func (ec *executionContext) _Ledger_importerVersion(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Preconditions",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2392,24 +5654,21 @@ func (ec *executionContext) _Ledger_importerVersion(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ImporterVersion, nil
+		return obj.LedgerBounds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.LedgerBounds)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOLedgerBounds2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐLedgerBounds(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_totalCoins(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Preconditions_minAccountSequence(ctx context.Context, field graphql.CollectedField, obj *model.Preconditions) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2417,7 +5676,7 @@ func (ec *executionContext) _Ledger_totalCoins(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Preconditions",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2426,24 +5685,21 @@ func (ec *executionContext) _Ledger_totalCoins(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TotalCoins, nil
+		return obj.MinAccountSequence, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_feePool(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Preconditions_minAccountSequenceAge(ctx context.Context, field graphql.CollectedField, obj *model.Preconditions) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2451,7 +5707,7 @@ func (ec *executionContext) _Ledger_feePool(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Preconditions",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2460,24 +5716,21 @@ func (ec *executionContext) _Ledger_feePool(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FeePool, nil
+		return obj.MinAccountSequenceAge, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_baseFee(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Preconditions_minAccountSequenceLedgerGap(ctx context.Context, field graphql.CollectedField, obj *model.Preconditions) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2485,7 +5738,7 @@ func (ec *executionContext) _Ledger_baseFee(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Preconditions",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2494,24 +5747,21 @@ func (ec *executionContext) _Ledger_baseFee(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BaseFee, nil
+		return obj.MinAccountSequenceLedgerGap, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_baseReserve(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Preconditions_extraSigners(ctx context.Context, field graphql.CollectedField, obj *model.Preconditions) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2519,7 +5769,7 @@ func (ec *executionContext) _Ledger_baseReserve(ctx context.Context, field graph
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Preconditions",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2528,7 +5778,7 @@ func (ec *executionContext) _Ledger_baseReserve(ctx context.Context, field graph
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.BaseReserve, nil
+		return obj.ExtraSigners, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2540,12 +5790,12 @@ func (ec *executionContext) _Ledger_baseReserve(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_maxTxSetSize(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_account(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2553,33 +5803,37 @@ func (ec *executionContext) _Ledger_maxTxSetSize(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_account_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MaxTxSetSize, nil
+		return ec.resolvers.Query().Account(rctx, args["pubKey"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Account)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOAccount2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAccount(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_protocolVersion(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_transaction(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2587,33 +5841,37 @@ func (ec *executionContext) _Ledger_protocolVersion(ctx context.Context, field g
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_transaction_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ProtocolVersion, nil
+		return ec.resolvers.Query().Transaction(rctx, args["hash"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*model.Transaction)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOTransaction2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_ledgerHeader(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_operation(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2621,16 +5879,23 @@ func (ec *executionContext) _Ledger_ledgerHeader(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_operation_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.LedgerHeader, nil
+		return ec.resolvers.Query().Operation(rctx, args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2639,12 +5904,12 @@ func (ec *executionContext) _Ledger_ledgerHeader(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.Operation)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOOperation2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐOperation(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_successfulTransactionCount(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_ledger(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2652,16 +5917,23 @@ func (ec *executionContext) _Ledger_successfulTransactionCount(ctx context.Conte
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_ledger_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SuccessfulTransactionCount, nil
+		return ec.resolvers.Query().Ledger(rctx, args["number"].(*int), args["limit"].(*int), args["order"].(*model.Order), args["filterBy"].(*model.FilterBy))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2670,12 +5942,12 @@ func (ec *executionContext) _Ledger_successfulTransactionCount(ctx context.Conte
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.([]*model.Ledger)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOLedger2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐLedger(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_failedTransactionCount(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_strictSendPaths(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2683,16 +5955,23 @@ func (ec *executionContext) _Ledger_failedTransactionCount(ctx context.Context,
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_strictSendPaths_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FailedTransactionCount, nil
+		return ec.resolvers.Query().StrictSendPaths(rctx, args["sourceAsset"].(string), args["sourceAmount"].(string), args["destinationAssets"].([]string), args["destinationAccount"].(*string), args["maxHops"].(*int), args["limit"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2701,12 +5980,12 @@ func (ec *executionContext) _Ledger_failedTransactionCount(ctx context.Context,
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*int)
+	res := resTmp.([]*model.Path)
 	fc.Result = res
-	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
+	return ec.marshalOPath2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐPath(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Ledger_transactions(ctx context.Context, field graphql.CollectedField, obj *model.Ledger) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_strictReceivePaths(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2714,7 +5993,7 @@ func (ec *executionContext) _Ledger_transactions(ctx context.Context, field grap
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Ledger",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
 		IsMethod: true,
@@ -2722,7 +6001,7 @@ func (ec *executionContext) _Ledger_transactions(ctx context.Context, field grap
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Ledger_transactions_args(ctx, rawArgs)
+	args, err := ec.field_Query_strictReceivePaths_args(ctx, rawArgs)
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
@@ -2730,7 +6009,7 @@ func (ec *executionContext) _Ledger_transactions(ctx context.Context, field grap
 	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Ledger().Transactions(rctx, obj, args["limit"].(*int), args["order"].(*model.Order))
+		return ec.resolvers.Query().StrictReceivePaths(rctx, args["sourceAssets"].([]string), args["sourceAccount"].(*string), args["destinationAsset"].(string), args["destinationAmount"].(string), args["maxHops"].(*int), args["limit"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2739,12 +6018,12 @@ func (ec *executionContext) _Ledger_transactions(ctx context.Context, field grap
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Transaction)
+	res := resTmp.([]*model.Path)
 	fc.Result = res
-	return ec.marshalOTransaction2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
+	return ec.marshalOPath2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐPath(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Operation_id(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_feeStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2752,33 +6031,37 @@ func (ec *executionContext) _Operation_id(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Operation",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query_feeStats_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Query().FeeStats(rctx, args["ledgers"].(*int))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
+	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.FeeStats)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOFeeStats2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFeeStats(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Operation_transactionID(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2786,33 +6069,37 @@ func (ec *executionContext) _Operation_transactionID(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Operation",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
+	rawArgs := field.ArgumentMap(ec.Variables)
+	args, err := ec.field_Query___type_args(ctx, rawArgs)
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TransactionID, nil
+		return ec.introspectType(args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Operation_applicationOrder(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2820,33 +6107,30 @@ func (ec *executionContext) _Operation_applicationOrder(ctx context.Context, fie
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Operation",
+		Object:   "Query",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ApplicationOrder, nil
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Operation_type(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
+func (ec *executionContext) _Signer_weight(ctx context.Context, field graphql.CollectedField, obj *model.Signer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2854,7 +6138,7 @@ func (ec *executionContext) _Operation_type(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Operation",
+		Object:   "Signer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2863,7 +6147,7 @@ func (ec *executionContext) _Operation_type(ctx context.Context, field graphql.C
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Weight, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2880,7 +6164,7 @@ func (ec *executionContext) _Operation_type(ctx context.Context, field graphql.C
 	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Operation_details(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
+func (ec *executionContext) _Signer_key(ctx context.Context, field graphql.CollectedField, obj *model.Signer) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2888,7 +6172,7 @@ func (ec *executionContext) _Operation_details(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Operation",
+		Object:   "Signer",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2897,21 +6181,24 @@ func (ec *executionContext) _Operation_details(ctx context.Context, field graphq
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Details, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Operation_sourceAccount(ctx context.Context, field graphql.CollectedField, obj *model.Operation) (ret graphql.Marshaler) {
+func (ec *executionContext) _SponsoredEntry_type(ctx context.Context, field graphql.CollectedField, obj *model.SponsoredEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2919,7 +6206,7 @@ func (ec *executionContext) _Operation_sourceAccount(ctx context.Context, field
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Operation",
+		Object:   "SponsoredEntry",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -2928,7 +6215,7 @@ func (ec *executionContext) _Operation_sourceAccount(ctx context.Context, field
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SourceAccount, nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2940,12 +6227,12 @@ func (ec *executionContext) _Operation_sourceAccount(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(model.SponsoredEntryType)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSponsoredEntryType2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSponsoredEntryType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_account(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _SponsoredEntry_account(ctx context.Context, field graphql.CollectedField, obj *model.SponsoredEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2953,23 +6240,16 @@ func (ec *executionContext) _Query_account(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "SponsoredEntry",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_account_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Account(rctx, args["pubKey"].(string))
+		return obj.Account, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -2978,12 +6258,12 @@ func (ec *executionContext) _Query_account(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*model.Account)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOAccount2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAccount(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_transaction(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _SponsoredEntry_sponsor(ctx context.Context, field graphql.CollectedField, obj *model.SponsoredEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -2991,37 +6271,33 @@ func (ec *executionContext) _Query_transaction(ctx context.Context, field graphq
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "SponsoredEntry",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_transaction_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Transaction(rctx, args["hash"].(string))
+		return obj.Sponsor, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.Transaction)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOTransaction2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query_ledger(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _SponsoredEntry_key(ctx context.Context, field graphql.CollectedField, obj *model.SponsoredEntry) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3029,37 +6305,33 @@ func (ec *executionContext) _Query_ledger(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "SponsoredEntry",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query_ledger_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().Ledger(rctx, args["number"].(*int), args["limit"].(*int), args["order"].(*model.Order), args["filterBy"].(*model.FilterBy))
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Ledger)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOLedger2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐLedger(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _TimeBounds_minTime(ctx context.Context, field graphql.CollectedField, obj *model.TimeBounds) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3067,23 +6339,16 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "TimeBounds",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
-	rawArgs := field.ArgumentMap(ec.Variables)
-	args, err := ec.field_Query___type_args(ctx, rawArgs)
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	fc.Args = args
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(args["name"].(string))
+		return obj.MinTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3092,12 +6357,12 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _TimeBounds_maxTime(ctx context.Context, field graphql.CollectedField, obj *model.TimeBounds) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3105,16 +6370,16 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Query",
+		Object:   "TimeBounds",
 		Field:    field,
 		Args:     nil,
-		IsMethod: true,
+		IsMethod: false,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.MaxTime, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3123,12 +6388,12 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(*time.Time)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalODateTime2ᚖtimeᚐTime(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Signer_weight(ctx context.Context, field graphql.CollectedField, obj *model.Signer) (ret graphql.Marshaler) {
+func (ec *executionContext) _TimeBounds_minTimeUnix(ctx context.Context, field graphql.CollectedField, obj *model.TimeBounds) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3136,7 +6401,7 @@ func (ec *executionContext) _Signer_weight(ctx context.Context, field graphql.Co
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Signer",
+		Object:   "TimeBounds",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3145,24 +6410,21 @@ func (ec *executionContext) _Signer_weight(ctx context.Context, field graphql.Co
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Weight, nil
+		return obj.MinTimeUnix, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(int)
+	res := resTmp.(*int64)
 	fc.Result = res
-	return ec.marshalNInt2int(ctx, field.Selections, res)
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Signer_key(ctx context.Context, field graphql.CollectedField, obj *model.Signer) (ret graphql.Marshaler) {
+func (ec *executionContext) _TimeBounds_maxTimeUnix(ctx context.Context, field graphql.CollectedField, obj *model.TimeBounds) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3170,7 +6432,7 @@ func (ec *executionContext) _Signer_key(ctx context.Context, field graphql.Colle
 		}
 	}()
 	fc := &graphql.FieldContext{
-		Object:   "Signer",
+		Object:   "TimeBounds",
 		Field:    field,
 		Args:     nil,
 		IsMethod: false,
@@ -3179,21 +6441,18 @@ func (ec *executionContext) _Signer_key(ctx context.Context, field graphql.Colle
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return obj.MaxTimeUnix, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt642ᚖint64(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _Transaction_transactionHash(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
@@ -3680,24 +6939,120 @@ func (ec *executionContext) _Transaction_signatures(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Signatures, nil
+		return obj.Signatures, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Transaction_memoType(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Transaction",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MemoType, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Transaction_memo(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Transaction",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Memo, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) _Transaction_timeBounds(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	fc := &graphql.FieldContext{
+		Object:   "Transaction",
+		Field:    field,
+		Args:     nil,
+		IsMethod: false,
+	}
+
+	ctx = graphql.WithFieldContext(ctx, fc)
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TimeBounds, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.([]*int)
 	fc.Result = res
-	return ec.marshalNString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚕᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_memoType(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_preconditions(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3708,30 +7063,27 @@ func (ec *executionContext) _Transaction_memoType(ctx context.Context, field gra
 		Object:   "Transaction",
 		Field:    field,
 		Args:     nil,
-		IsMethod: false,
+		IsMethod: true,
 	}
 
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MemoType, nil
+		return ec.resolvers.Transaction().Preconditions(rctx, obj)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.Preconditions)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOPreconditions2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐPreconditions(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_memo(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_successful(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3748,7 +7100,7 @@ func (ec *executionContext) _Transaction_memo(ctx context.Context, field graphql
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Memo, nil
+		return obj.Successful, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3757,12 +7109,12 @@ func (ec *executionContext) _Transaction_memo(ctx context.Context, field graphql
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*bool)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_timeBounds(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_feeCharged(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3779,7 +7131,7 @@ func (ec *executionContext) _Transaction_timeBounds(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TimeBounds, nil
+		return obj.FeeCharged, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3788,12 +7140,12 @@ func (ec *executionContext) _Transaction_timeBounds(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*int)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalOInt2ᚕᚖint(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_successful(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_feeBump(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3810,7 +7162,7 @@ func (ec *executionContext) _Transaction_successful(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Successful, nil
+		return obj.FeeBump, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3819,12 +7171,12 @@ func (ec *executionContext) _Transaction_successful(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*bool)
+	res := resTmp.(*model.FeeBumpInfo)
 	fc.Result = res
-	return ec.marshalOBoolean2ᚖbool(ctx, field.Selections, res)
+	return ec.marshalOFeeBumpInfo2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFeeBumpInfo(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) _Transaction_feeCharged(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
+func (ec *executionContext) _Transaction_innerTransaction(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -3841,7 +7193,7 @@ func (ec *executionContext) _Transaction_feeCharged(ctx context.Context, field g
 	ctx = graphql.WithFieldContext(ctx, fc)
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FeeCharged, nil
+		return obj.InnerTransaction, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3850,9 +7202,9 @@ func (ec *executionContext) _Transaction_feeCharged(ctx context.Context, field g
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.Transaction)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOTransaction2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTransaction(ctx, field.Selections, res)
 }
 
 func (ec *executionContext) _Transaction_innerTransactionHash(ctx context.Context, field graphql.CollectedField, obj *model.Transaction) (ret graphql.Marshaler) {
@@ -5213,6 +8565,40 @@ func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
+		case "availableNativeBalance":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Account_availableNativeBalance(ctx, field, obj)
+				return res
+			})
+		case "minimumBalance":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Account_minimumBalance(ctx, field, obj)
+				return res
+			})
+		case "inflationDestination":
+			out.Values[i] = ec._Account_inflationDestination(ctx, field, obj)
+		case "subentryCount":
+			out.Values[i] = ec._Account_subentryCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "lastModifiedLedger":
+			out.Values[i] = ec._Account_lastModifiedLedger(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		case "masterWeight":
 			out.Values[i] = ec._Account_masterWeight(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -5238,6 +8624,18 @@ func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				atomic.AddUint32(&invalids, 1)
 			}
+		case "sponsor":
+			out.Values[i] = ec._Account_sponsor(ctx, field, obj)
+		case "numSponsoring":
+			out.Values[i] = ec._Account_numSponsoring(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
+		case "numSponsored":
+			out.Values[i] = ec._Account_numSponsored(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				atomic.AddUint32(&invalids, 1)
+			}
 		case "balances":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -5282,6 +8680,59 @@ func (ec *executionContext) _Account(ctx context.Context, sel ast.SelectionSet,
 				res = ec._Account_transactions(ctx, field, obj)
 				return res
 			})
+		case "sponsoring":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Account_sponsoring(ctx, field, obj)
+				return res
+			})
+		case "sponsoredBy":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Account_sponsoredBy(ctx, field, obj)
+				return res
+			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var assetImplementors = []string{"Asset"}
+
+func (ec *executionContext) _Asset(ctx context.Context, sel ast.SelectionSet, obj *model.Asset) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, assetImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Asset")
+		case "type":
+			out.Values[i] = ec._Asset_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "code":
+			out.Values[i] = ec._Asset_code(ctx, field, obj)
+		case "issuer":
+			out.Values[i] = ec._Asset_issuer(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5309,38 +8760,214 @@ func (ec *executionContext) _Balance(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "buyingLiabilities":
-			out.Values[i] = ec._Balance_buyingLiabilities(ctx, field, obj)
+		case "buyingLiabilities":
+			out.Values[i] = ec._Balance_buyingLiabilities(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sellingLiabilities":
+			out.Values[i] = ec._Balance_sellingLiabilities(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "limit":
+			out.Values[i] = ec._Balance_limit(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lastModifiedLedger":
+			out.Values[i] = ec._Balance_lastModifiedLedger(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "isAuthorized":
+			out.Values[i] = ec._Balance_isAuthorized(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "assetCode":
+			out.Values[i] = ec._Balance_assetCode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "assetIssuer":
+			out.Values[i] = ec._Balance_assetIssuer(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var dataImplementors = []string{"Data"}
+
+func (ec *executionContext) _Data(ctx context.Context, sel ast.SelectionSet, obj *model.Data) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dataImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Data")
+		case "name":
+			out.Values[i] = ec._Data_name(ctx, field, obj)
+		case "value":
+			out.Values[i] = ec._Data_value(ctx, field, obj)
+		case "rawValue":
+			out.Values[i] = ec._Data_rawValue(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "hex":
+			out.Values[i] = ec._Data_hex(ctx, field, obj)
+		case "byteLength":
+			out.Values[i] = ec._Data_byteLength(ctx, field, obj)
+		case "lastModifiedLedger":
+			out.Values[i] = ec._Data_lastModifiedLedger(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sponsor":
+			out.Values[i] = ec._Data_sponsor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var feeBumpInfoImplementors = []string{"FeeBumpInfo"}
+
+func (ec *executionContext) _FeeBumpInfo(ctx context.Context, sel ast.SelectionSet, obj *model.FeeBumpInfo) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, feeBumpInfoImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FeeBumpInfo")
+		case "transactionHash":
+			out.Values[i] = ec._FeeBumpInfo_transactionHash(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "feeAccount":
+			out.Values[i] = ec._FeeBumpInfo_feeAccount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "maxFee":
+			out.Values[i] = ec._FeeBumpInfo_maxFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "signatures":
+			out.Values[i] = ec._FeeBumpInfo_signatures(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var feeDistributionImplementors = []string{"FeeDistribution"}
+
+func (ec *executionContext) _FeeDistribution(ctx context.Context, sel ast.SelectionSet, obj *model.FeeDistribution) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, feeDistributionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FeeDistribution")
+		case "min":
+			out.Values[i] = ec._FeeDistribution_min(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "mode":
+			out.Values[i] = ec._FeeDistribution_mode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "max":
+			out.Values[i] = ec._FeeDistribution_max(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "p10":
+			out.Values[i] = ec._FeeDistribution_p10(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "p20":
+			out.Values[i] = ec._FeeDistribution_p20(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "p30":
+			out.Values[i] = ec._FeeDistribution_p30(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "p40":
+			out.Values[i] = ec._FeeDistribution_p40(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "p50":
+			out.Values[i] = ec._FeeDistribution_p50(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "sellingLiabilities":
-			out.Values[i] = ec._Balance_sellingLiabilities(ctx, field, obj)
+		case "p60":
+			out.Values[i] = ec._FeeDistribution_p60(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "limit":
-			out.Values[i] = ec._Balance_limit(ctx, field, obj)
+		case "p70":
+			out.Values[i] = ec._FeeDistribution_p70(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "lastModifiedLedger":
-			out.Values[i] = ec._Balance_lastModifiedLedger(ctx, field, obj)
+		case "p80":
+			out.Values[i] = ec._FeeDistribution_p80(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "isAuthorized":
-			out.Values[i] = ec._Balance_isAuthorized(ctx, field, obj)
+		case "p90":
+			out.Values[i] = ec._FeeDistribution_p90(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "assetCode":
-			out.Values[i] = ec._Balance_assetCode(ctx, field, obj)
+		case "p95":
+			out.Values[i] = ec._FeeDistribution_p95(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
-		case "assetIssuer":
-			out.Values[i] = ec._Balance_assetIssuer(ctx, field, obj)
+		case "p99":
+			out.Values[i] = ec._FeeDistribution_p99(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				invalids++
 			}
@@ -5355,21 +8982,57 @@ func (ec *executionContext) _Balance(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var dataImplementors = []string{"Data"}
+var feeStatsImplementors = []string{"FeeStats"}
 
-func (ec *executionContext) _Data(ctx context.Context, sel ast.SelectionSet, obj *model.Data) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dataImplementors)
+func (ec *executionContext) _FeeStats(ctx context.Context, sel ast.SelectionSet, obj *model.FeeStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, feeStatsImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	var invalids uint32
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Data")
-		case "name":
-			out.Values[i] = ec._Data_name(ctx, field, obj)
-		case "value":
-			out.Values[i] = ec._Data_value(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("FeeStats")
+		case "lastLedger":
+			out.Values[i] = ec._FeeStats_lastLedger(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "lastLedgerBaseFee":
+			out.Values[i] = ec._FeeStats_lastLedgerBaseFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "ledgerCount":
+			out.Values[i] = ec._FeeStats_ledgerCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "ledgerCapacityUsage":
+			out.Values[i] = ec._FeeStats_ledgerCapacityUsage(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "operationCount":
+			out.Values[i] = ec._FeeStats_operationCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "maxTxSetSize":
+			out.Values[i] = ec._FeeStats_maxTxSetSize(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "feeCharged":
+			out.Values[i] = ec._FeeStats_feeCharged(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "maxFee":
+			out.Values[i] = ec._FeeStats_maxFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -5525,6 +9188,35 @@ func (ec *executionContext) _Ledger(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
+var ledgerBoundsImplementors = []string{"LedgerBounds"}
+
+func (ec *executionContext) _LedgerBounds(ctx context.Context, sel ast.SelectionSet, obj *model.LedgerBounds) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, ledgerBoundsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("LedgerBounds")
+		case "minLedger":
+			out.Values[i] = ec._LedgerBounds_minLedger(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "maxLedger":
+			out.Values[i] = ec._LedgerBounds_maxLedger(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var operationImplementors = []string{"Operation"}
 
 func (ec *executionContext) _Operation(ctx context.Context, sel ast.SelectionSet, obj *model.Operation) graphql.Marshaler {
@@ -5574,6 +9266,90 @@ func (ec *executionContext) _Operation(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
+var pathImplementors = []string{"Path"}
+
+func (ec *executionContext) _Path(ctx context.Context, sel ast.SelectionSet, obj *model.Path) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pathImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Path")
+		case "sourceAsset":
+			out.Values[i] = ec._Path_sourceAsset(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "sourceAmount":
+			out.Values[i] = ec._Path_sourceAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "destinationAsset":
+			out.Values[i] = ec._Path_destinationAsset(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "destinationAmount":
+			out.Values[i] = ec._Path_destinationAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "path":
+			out.Values[i] = ec._Path_path(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var preconditionsImplementors = []string{"Preconditions"}
+
+func (ec *executionContext) _Preconditions(ctx context.Context, sel ast.SelectionSet, obj *model.Preconditions) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, preconditionsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Preconditions")
+		case "timeBounds":
+			out.Values[i] = ec._Preconditions_timeBounds(ctx, field, obj)
+		case "ledgerBounds":
+			out.Values[i] = ec._Preconditions_ledgerBounds(ctx, field, obj)
+		case "minAccountSequence":
+			out.Values[i] = ec._Preconditions_minAccountSequence(ctx, field, obj)
+		case "minAccountSequenceAge":
+			out.Values[i] = ec._Preconditions_minAccountSequenceAge(ctx, field, obj)
+		case "minAccountSequenceLedgerGap":
+			out.Values[i] = ec._Preconditions_minAccountSequenceLedgerGap(ctx, field, obj)
+		case "extraSigners":
+			out.Values[i] = ec._Preconditions_extraSigners(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var queryImplementors = []string{"Query"}
 
 func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
@@ -5611,6 +9387,17 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_transaction(ctx, field)
 				return res
 			})
+		case "operation":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_operation(ctx, field)
+				return res
+			})
 		case "ledger":
 			field := field
 			out.Concurrently(i, func() (res graphql.Marshaler) {
@@ -5622,6 +9409,39 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 				res = ec._Query_ledger(ctx, field)
 				return res
 			})
+		case "strictSendPaths":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_strictSendPaths(ctx, field)
+				return res
+			})
+		case "strictReceivePaths":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_strictReceivePaths(ctx, field)
+				return res
+			})
+		case "feeStats":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_feeStats(ctx, field)
+				return res
+			})
 		case "__type":
 			out.Values[i] = ec._Query___type(ctx, field)
 		case "__schema":
@@ -5669,6 +9489,75 @@ func (ec *executionContext) _Signer(ctx context.Context, sel ast.SelectionSet, o
 	return out
 }
 
+var sponsoredEntryImplementors = []string{"SponsoredEntry"}
+
+func (ec *executionContext) _SponsoredEntry(ctx context.Context, sel ast.SelectionSet, obj *model.SponsoredEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, sponsoredEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SponsoredEntry")
+		case "type":
+			out.Values[i] = ec._SponsoredEntry_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "account":
+			out.Values[i] = ec._SponsoredEntry_account(ctx, field, obj)
+		case "sponsor":
+			out.Values[i] = ec._SponsoredEntry_sponsor(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		case "key":
+			out.Values[i] = ec._SponsoredEntry_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+var timeBoundsImplementors = []string{"TimeBounds"}
+
+func (ec *executionContext) _TimeBounds(ctx context.Context, sel ast.SelectionSet, obj *model.TimeBounds) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, timeBoundsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	var invalids uint32
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("TimeBounds")
+		case "minTime":
+			out.Values[i] = ec._TimeBounds_minTime(ctx, field, obj)
+		case "maxTime":
+			out.Values[i] = ec._TimeBounds_maxTime(ctx, field, obj)
+		case "minTimeUnix":
+			out.Values[i] = ec._TimeBounds_minTimeUnix(ctx, field, obj)
+		case "maxTimeUnix":
+			out.Values[i] = ec._TimeBounds_maxTimeUnix(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
 var transactionImplementors = []string{"Transaction"}
 
 func (ec *executionContext) _Transaction(ctx context.Context, sel ast.SelectionSet, obj *model.Transaction) graphql.Marshaler {
@@ -5755,10 +9644,25 @@ func (ec *executionContext) _Transaction(ctx context.Context, sel ast.SelectionS
 			out.Values[i] = ec._Transaction_memo(ctx, field, obj)
 		case "timeBounds":
 			out.Values[i] = ec._Transaction_timeBounds(ctx, field, obj)
+		case "preconditions":
+			field := field
+			out.Concurrently(i, func() (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Transaction_preconditions(ctx, field, obj)
+				return res
+			})
 		case "successful":
 			out.Values[i] = ec._Transaction_successful(ctx, field, obj)
 		case "feeCharged":
 			out.Values[i] = ec._Transaction_feeCharged(ctx, field, obj)
+		case "feeBump":
+			out.Values[i] = ec._Transaction_feeBump(ctx, field, obj)
+		case "innerTransaction":
+			out.Values[i] = ec._Transaction_innerTransaction(ctx, field, obj)
 		case "innerTransactionHash":
 			out.Values[i] = ec._Transaction_innerTransactionHash(ctx, field, obj)
 		case "feeAccount":
@@ -6023,23 +9927,83 @@ func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, o
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-	out.Dispatch()
-	if invalids > 0 {
+	out.Dispatch()
+	if invalids > 0 {
+		return graphql.Null
+	}
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNAccountFilterOption2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAccountFilterOption(ctx context.Context, v interface{}) (model.AccountFilterOption, error) {
+	var res model.AccountFilterOption
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNAccountFilterOption2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAccountFilterOption(ctx context.Context, sel ast.SelectionSet, v model.AccountFilterOption) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNAsset2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAsset(ctx context.Context, sel ast.SelectionSet, v model.Asset) graphql.Marshaler {
+	return ec._Asset(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNAsset2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAssetᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Asset) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNAsset2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAsset(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalNAsset2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAsset(ctx context.Context, sel ast.SelectionSet, v *model.Asset) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	return out
+	return ec._Asset(ctx, sel, v)
 }
 
-// endregion **************************** object.gotpl ****************************
-
-// region    ***************************** type.gotpl *****************************
-
-func (ec *executionContext) unmarshalNAccountFilterOption2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAccountFilterOption(ctx context.Context, v interface{}) (model.AccountFilterOption, error) {
-	var res model.AccountFilterOption
+func (ec *executionContext) unmarshalNAssetType2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAssetType(ctx context.Context, v interface{}) (model.AssetType, error) {
+	var res model.AssetType
 	return res, res.UnmarshalGQL(v)
 }
 
-func (ec *executionContext) marshalNAccountFilterOption2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAccountFilterOption(ctx context.Context, sel ast.SelectionSet, v model.AccountFilterOption) graphql.Marshaler {
+func (ec *executionContext) marshalNAssetType2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐAssetType(ctx context.Context, sel ast.SelectionSet, v model.AssetType) graphql.Marshaler {
 	return v
 }
 
@@ -6057,6 +10021,20 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalNFeeDistribution2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFeeDistribution(ctx context.Context, sel ast.SelectionSet, v model.FeeDistribution) graphql.Marshaler {
+	return ec._FeeDistribution(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNFeeDistribution2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFeeDistribution(ctx context.Context, sel ast.SelectionSet, v *model.FeeDistribution) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	return ec._FeeDistribution(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNFlags2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFlags(ctx context.Context, sel ast.SelectionSet, v model.Flags) graphql.Marshaler {
 	return ec._Flags(ctx, sel, &v)
 }
@@ -6071,6 +10049,20 @@ func (ec *executionContext) marshalNFlags2ᚖgithubᚗcomᚋowenjacobᚋhubblegr
 	return ec._Flags(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	return graphql.UnmarshalFloat(v)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloat(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
 	return graphql.UnmarshalInt(v)
 }
@@ -6085,6 +10077,29 @@ func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.Selecti
 	return res
 }
 
+func (ec *executionContext) unmarshalNInt642int64(ctx context.Context, v interface{}) (int64, error) {
+	return model.UnmarshalInt64(v)
+}
+
+func (ec *executionContext) marshalNInt642int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
+	res := model.MarshalInt64(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "must not be null")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNSponsoredEntryType2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSponsoredEntryType(ctx context.Context, v interface{}) (model.SponsoredEntryType, error) {
+	var res model.SponsoredEntryType
+	return res, res.UnmarshalGQL(v)
+}
+
+func (ec *executionContext) marshalNSponsoredEntryType2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSponsoredEntryType(ctx context.Context, sel ast.SelectionSet, v model.SponsoredEntryType) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -6514,6 +10529,51 @@ func (ec *executionContext) unmarshalODateFilter2ᚖgithubᚗcomᚋowenjacobᚋh
 	return &res, err
 }
 
+func (ec *executionContext) unmarshalODateTime2timeᚐTime(ctx context.Context, v interface{}) (time.Time, error) {
+	return graphql.UnmarshalTime(v)
+}
+
+func (ec *executionContext) marshalODateTime2timeᚐTime(ctx context.Context, sel ast.SelectionSet, v time.Time) graphql.Marshaler {
+	return graphql.MarshalTime(v)
+}
+
+func (ec *executionContext) unmarshalODateTime2ᚖtimeᚐTime(ctx context.Context, v interface{}) (*time.Time, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalODateTime2timeᚐTime(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalODateTime2ᚖtimeᚐTime(ctx context.Context, sel ast.SelectionSet, v *time.Time) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec.marshalODateTime2timeᚐTime(ctx, sel, *v)
+}
+
+func (ec *executionContext) marshalOFeeBumpInfo2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFeeBumpInfo(ctx context.Context, sel ast.SelectionSet, v model.FeeBumpInfo) graphql.Marshaler {
+	return ec._FeeBumpInfo(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOFeeBumpInfo2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFeeBumpInfo(ctx context.Context, sel ast.SelectionSet, v *model.FeeBumpInfo) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FeeBumpInfo(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOFeeStats2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFeeStats(ctx context.Context, sel ast.SelectionSet, v model.FeeStats) graphql.Marshaler {
+	return ec._FeeStats(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOFeeStats2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFeeStats(ctx context.Context, sel ast.SelectionSet, v *model.FeeStats) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FeeStats(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOFilterBy2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐFilterBy(ctx context.Context, v interface{}) (model.FilterBy, error) {
 	return ec.unmarshalInputFilterBy(ctx, v)
 }
@@ -6581,6 +10641,29 @@ func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.Sele
 	return ec.marshalOInt2int(ctx, sel, *v)
 }
 
+func (ec *executionContext) unmarshalOInt642int64(ctx context.Context, v interface{}) (int64, error) {
+	return model.UnmarshalInt64(v)
+}
+
+func (ec *executionContext) marshalOInt642int64(ctx context.Context, sel ast.SelectionSet, v int64) graphql.Marshaler {
+	return model.MarshalInt64(v)
+}
+
+func (ec *executionContext) unmarshalOInt642ᚖint64(ctx context.Context, v interface{}) (*int64, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalOInt642int64(ctx, v)
+	return &res, err
+}
+
+func (ec *executionContext) marshalOInt642ᚖint64(ctx context.Context, sel ast.SelectionSet, v *int64) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec.marshalOInt642int64(ctx, sel, *v)
+}
+
 func (ec *executionContext) marshalOLedger2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐLedger(ctx context.Context, sel ast.SelectionSet, v model.Ledger) graphql.Marshaler {
 	return ec._Ledger(ctx, sel, &v)
 }
@@ -6632,6 +10715,17 @@ func (ec *executionContext) marshalOLedger2ᚖgithubᚗcomᚋowenjacobᚋhubbleg
 	return ec._Ledger(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOLedgerBounds2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐLedgerBounds(ctx context.Context, sel ast.SelectionSet, v model.LedgerBounds) graphql.Marshaler {
+	return ec._LedgerBounds(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOLedgerBounds2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐLedgerBounds(ctx context.Context, sel ast.SelectionSet, v *model.LedgerBounds) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._LedgerBounds(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOLedgerFilter2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐLedgerFilter(ctx context.Context, v interface{}) (model.LedgerFilter, error) {
 	return ec.unmarshalInputLedgerFilter(ctx, v)
 }
@@ -6719,6 +10813,68 @@ func (ec *executionContext) marshalOOrder2ᚖgithubᚗcomᚋowenjacobᚋhubblegr
 	return v
 }
 
+func (ec *executionContext) marshalOPath2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐPath(ctx context.Context, sel ast.SelectionSet, v model.Path) graphql.Marshaler {
+	return ec._Path(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOPath2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐPath(ctx context.Context, sel ast.SelectionSet, v []*model.Path) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOPath2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐPath(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOPath2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐPath(ctx context.Context, sel ast.SelectionSet, v *model.Path) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Path(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOPreconditions2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐPreconditions(ctx context.Context, sel ast.SelectionSet, v model.Preconditions) graphql.Marshaler {
+	return ec._Preconditions(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOPreconditions2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐPreconditions(ctx context.Context, sel ast.SelectionSet, v *model.Preconditions) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Preconditions(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOSigner2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSigner(ctx context.Context, sel ast.SelectionSet, v model.Signer) graphql.Marshaler {
 	return ec._Signer(ctx, sel, &v)
 }
@@ -6770,6 +10926,57 @@ func (ec *executionContext) marshalOSigner2ᚖgithubᚗcomᚋowenjacobᚋhubbleg
 	return ec._Signer(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOSponsoredEntry2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSponsoredEntry(ctx context.Context, sel ast.SelectionSet, v model.SponsoredEntry) graphql.Marshaler {
+	return ec._SponsoredEntry(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOSponsoredEntry2ᚕᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSponsoredEntry(ctx context.Context, sel ast.SelectionSet, v []*model.SponsoredEntry) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalOSponsoredEntry2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSponsoredEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+	return ret
+}
+
+func (ec *executionContext) marshalOSponsoredEntry2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐSponsoredEntry(ctx context.Context, sel ast.SelectionSet, v *model.SponsoredEntry) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SponsoredEntry(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOString2string(ctx context.Context, v interface{}) (string, error) {
 	return graphql.UnmarshalString(v)
 }
@@ -6778,6 +10985,38 @@ func (ec *executionContext) marshalOString2string(ctx context.Context, sel ast.S
 	return graphql.MarshalString(v)
 }
 
+func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		if tmp1, ok := v.([]interface{}); ok {
+			vSlice = tmp1
+		} else {
+			vSlice = []interface{}{v}
+		}
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalOString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	return ret
+}
+
 func (ec *executionContext) unmarshalOString2ᚕᚖstring(ctx context.Context, v interface{}) ([]*string, error) {
 	var vSlice []interface{}
 	if v != nil {
@@ -6825,6 +11064,17 @@ func (ec *executionContext) marshalOString2ᚖstring(ctx context.Context, sel as
 	return ec.marshalOString2string(ctx, sel, *v)
 }
 
+func (ec *executionContext) marshalOTimeBounds2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTimeBounds(ctx context.Context, sel ast.SelectionSet, v model.TimeBounds) graphql.Marshaler {
+	return ec._TimeBounds(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalOTimeBounds2ᚖgithubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTimeBounds(ctx context.Context, sel ast.SelectionSet, v *model.TimeBounds) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._TimeBounds(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOTransaction2githubᚗcomᚋowenjacobᚋhubblegraphqlᚋgraphᚋmodelᚐTransaction(ctx context.Context, sel ast.SelectionSet, v model.Transaction) graphql.Marshaler {
 	return ec._Transaction(ctx, sel, &v)
 }
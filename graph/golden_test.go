@@ -0,0 +1,114 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/owenjacob/hubblegraphql/apierror"
+	"github.com/owenjacob/hubblegraphql/graph/generated"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files from the in-memory store")
+
+// Every testdata/queries/NAME.graphql is run against the fixtures and its response compared
+// with testdata/golden/NAME.json. Both stores have to give the same answer. Queries refer to
+// fixture keys as {{alice}}, {{tx1}} and so on.
+func TestGolden(t *testing.T) {
+	queries, err := filepath.Glob(filepath.Join("testdata", "queries", "*.graphql"))
+	if err != nil || len(queries) == 0 {
+		t.Fatalf("no golden queries: %v", err)
+	}
+
+	t.Run("memory", func(t *testing.T) {
+		runGolden(t, NewMemoryStores(fixtures()), queries, *update)
+	})
+	t.Run("postgres", func(t *testing.T) {
+		if *update {
+			t.Skip("golden files are only written from the in-memory store")
+		}
+		runGolden(t, NewPostgresStores(openTestPostgres(t, fixtures()), nil), queries, false)
+	})
+}
+
+func runGolden(t *testing.T, stores Stores, queries []string, update bool) {
+	h := testHandler(stores)
+	for _, path := range queries {
+		name := strings.TrimSuffix(filepath.Base(path), ".graphql")
+		t.Run(name, func(t *testing.T) {
+			query, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := execute(t, h, fixtureKeys().Replace(string(query)))
+
+			golden := filepath.Join("testdata", "golden", name+".json")
+			if update {
+				if err := os.WriteFile(golden, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%v, run go test ./graph -run TestGolden -update to create it", err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("response differs from %s\ngot:\n%s\nwant:\n%s", golden, got, want)
+			}
+		})
+	}
+}
+
+func fixtureKeys() *strings.Replacer {
+	return strings.NewReplacer(
+		"{{alice}}", alice,
+		"{{bob}}", bob,
+		"{{issuer}}", issuer,
+		"{{tx1}}", hashOf("tx", 1),
+		"{{tx2}}", hashOf("tx", 2),
+		"{{tx3}}", hashOf("tx", 3),
+		"{{tx2inner}}", hashOf("inner", 2),
+	)
+}
+
+// Configured like the server's handler, minus the transports and extensions that only matter
+// over a real connection
+func testHandler(stores Stores) *handler.Server {
+	h := handler.New(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  &Resolver{Stores: stores, Limits: DefaultLimits},
+		Complexity: Complexity(DefaultLimits),
+	}))
+	h.AddTransport(transport.POST{})
+	h.SetErrorPresenter(apierror.Presenter)
+	h.SetRecoverFunc(apierror.Recover)
+	h.Use(DepthLimit{Limit: DefaultLimits.MaxQueryDepth})
+	h.Use(extension.FixedComplexityLimit(DefaultLimits.MaxQueryComplexity))
+	return h
+}
+
+// Posts the query and returns the response indented, so golden files diff line by line
+func execute(t *testing.T, h http.Handler, query string) []byte {
+	t.Helper()
+	body, _ := json.Marshal(map[string]string{"query": query})
+	req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	res := httptest.NewRecorder()
+	h.ServeHTTP(res, req)
+
+	indented := &bytes.Buffer{}
+	if err := json.Indent(indented, res.Body.Bytes(), "", "  "); err != nil {
+		t.Fatalf("response is not JSON: %v\n%s", err, res.Body.String())
+	}
+	indented.WriteByte('\n')
+	return indented.Bytes()
+}
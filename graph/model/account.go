@@ -0,0 +1,25 @@
+package model
+
+// Account is written by hand rather than generated so that it can carry the stroop amounts
+// that minimumBalance and availableNativeBalance are resolved from, only when asked for
+type Account struct {
+	ID                   string  `json:"id"`
+	Sequence             string  `json:"sequence"`
+	HomeDomain           string  `json:"homeDomain"`
+	NativeBalance        string  `json:"nativeBalance"`
+	InflationDestination *string `json:"inflationDestination"`
+	SubentryCount        int     `json:"subentryCount"`
+	LastModifiedLedger   int     `json:"lastModifiedLedger"`
+	MasterWeight         int     `json:"masterWeight"`
+	LowThreshold         int     `json:"lowThreshold"`
+	MediumThreshold      int     `json:"mediumThreshold"`
+	HighThreshold        int     `json:"highThreshold"`
+	Flags                *Flags  `json:"flags"`
+	Sponsor              *string `json:"sponsor"`
+	NumSponsoring        int     `json:"numSponsoring"`
+	NumSponsored         int     `json:"numSponsored"`
+
+	// Native balance and selling liabilities in stroops
+	Balance            int64 `json:"-"`
+	SellingLiabilities int64 `json:"-"`
+}
@@ -6,29 +6,20 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
-type Account struct {
-	ID              string         `json:"id"`
-	Sequence        string         `json:"sequence"`
-	HomeDomain      string         `json:"homeDomain"`
-	NativeBalance   string         `json:"nativeBalance"`
-	MasterWeight    int            `json:"masterWeight"`
-	LowThreshold    int            `json:"lowThreshold"`
-	MediumThreshold int            `json:"mediumThreshold"`
-	HighThreshold   int            `json:"highThreshold"`
-	Flags           *Flags         `json:"flags"`
-	Balances        []*Balance     `json:"balances"`
-	Signers         []*Signer      `json:"signers"`
-	Data            []*Data        `json:"data"`
-	Transactions    []*Transaction `json:"transactions"`
-}
-
 type AccountFilter struct {
 	PubKey    string              `json:"pubKey"`
 	Direction AccountFilterOption `json:"direction"`
 }
 
+type Asset struct {
+	Type   AssetType `json:"type"`
+	Code   *string   `json:"code"`
+	Issuer *string   `json:"issuer"`
+}
+
 type Balance struct {
 	Balance            string `json:"balance"`
 	BuyingLiabilities  string `json:"buyingLiabilities"`
@@ -41,8 +32,13 @@ type Balance struct {
 }
 
 type Data struct {
-	Name  *string `json:"name"`
-	Value *string `json:"value"`
+	Name               *string `json:"name"`
+	Value              *string `json:"value"`
+	RawValue           string  `json:"rawValue"`
+	Hex                *string `json:"hex"`
+	ByteLength         *int    `json:"byteLength"`
+	LastModifiedLedger int     `json:"lastModifiedLedger"`
+	Sponsor            *string `json:"sponsor"`
 }
 
 type DateFilter struct {
@@ -50,6 +46,41 @@ type DateFilter struct {
 	ToDate   string `json:"toDate"`
 }
 
+type FeeBumpInfo struct {
+	TransactionHash string   `json:"transactionHash"`
+	FeeAccount      string   `json:"feeAccount"`
+	MaxFee          string   `json:"maxFee"`
+	Signatures      []string `json:"signatures"`
+}
+
+type FeeDistribution struct {
+	Min  int64 `json:"min"`
+	Mode int64 `json:"mode"`
+	Max  int64 `json:"max"`
+	P10  int64 `json:"p10"`
+	P20  int64 `json:"p20"`
+	P30  int64 `json:"p30"`
+	P40  int64 `json:"p40"`
+	P50  int64 `json:"p50"`
+	P60  int64 `json:"p60"`
+	P70  int64 `json:"p70"`
+	P80  int64 `json:"p80"`
+	P90  int64 `json:"p90"`
+	P95  int64 `json:"p95"`
+	P99  int64 `json:"p99"`
+}
+
+type FeeStats struct {
+	LastLedger          int              `json:"lastLedger"`
+	LastLedgerBaseFee   int              `json:"lastLedgerBaseFee"`
+	LedgerCount         int              `json:"ledgerCount"`
+	LedgerCapacityUsage float64          `json:"ledgerCapacityUsage"`
+	OperationCount      int              `json:"operationCount"`
+	MaxTxSetSize        int              `json:"maxTxSetSize"`
+	FeeCharged          *FeeDistribution `json:"feeCharged"`
+	MaxFee              *FeeDistribution `json:"maxFee"`
+}
+
 type FilterBy struct {
 	Account *AccountFilter `json:"account"`
 	Date    *DateFilter    `json:"date"`
@@ -85,6 +116,11 @@ type Ledger struct {
 	Transactions               []*Transaction `json:"transactions"`
 }
 
+type LedgerBounds struct {
+	MinLedger int  `json:"minLedger"`
+	MaxLedger *int `json:"maxLedger"`
+}
+
 type LedgerFilter struct {
 	FromNumber int `json:"fromNumber"`
 	ToNumber   int `json:"toNumber"`
@@ -99,37 +135,71 @@ type Operation struct {
 	SourceAccount    string  `json:"sourceAccount"`
 }
 
+type Path struct {
+	SourceAsset       *Asset   `json:"sourceAsset"`
+	SourceAmount      string   `json:"sourceAmount"`
+	DestinationAsset  *Asset   `json:"destinationAsset"`
+	DestinationAmount string   `json:"destinationAmount"`
+	Path              []*Asset `json:"path"`
+}
+
+type Preconditions struct {
+	TimeBounds                  *TimeBounds   `json:"timeBounds"`
+	LedgerBounds                *LedgerBounds `json:"ledgerBounds"`
+	MinAccountSequence          *int64        `json:"minAccountSequence"`
+	MinAccountSequenceAge       *int64        `json:"minAccountSequenceAge"`
+	MinAccountSequenceLedgerGap *int          `json:"minAccountSequenceLedgerGap"`
+	ExtraSigners                []string      `json:"extraSigners"`
+}
+
 type Signer struct {
 	Weight int    `json:"weight"`
 	Key    string `json:"key"`
 }
 
+type SponsoredEntry struct {
+	Type    SponsoredEntryType `json:"type"`
+	Account *string            `json:"account"`
+	Sponsor string             `json:"sponsor"`
+	Key     string             `json:"key"`
+}
+
+type TimeBounds struct {
+	MinTime     *time.Time `json:"minTime"`
+	MaxTime     *time.Time `json:"maxTime"`
+	MinTimeUnix *int64     `json:"minTimeUnix"`
+	MaxTimeUnix *int64     `json:"maxTimeUnix"`
+}
+
 type Transaction struct {
-	TransactionHash      string       `json:"transactionHash"`
-	LedgerSequence       int          `json:"ledgerSequence"`
-	ApplicationOrder     int          `json:"applicationOrder"`
-	Account              string       `json:"account"`
-	AccountSequence      string       `json:"accountSequence"`
-	MaxFee               string       `json:"maxFee"`
-	OperationCount       int          `json:"operationCount"`
-	CreatedAt            *string      `json:"createdAt"`
-	UpdatedAt            *string      `json:"updatedAt"`
-	ID                   *string      `json:"id"`
-	TxEnvelope           string       `json:"txEnvelope"`
-	TxResult             string       `json:"txResult"`
-	TxMeta               string       `json:"txMeta"`
-	TxFeeMeta            string       `json:"txFeeMeta"`
-	Signatures           []string     `json:"signatures"`
-	MemoType             string       `json:"memoType"`
-	Memo                 *string      `json:"memo"`
-	TimeBounds           []*int       `json:"timeBounds"`
-	Successful           *bool        `json:"successful"`
-	FeeCharged           *string      `json:"feeCharged"`
-	InnerTransactionHash *string      `json:"innerTransactionHash"`
-	FeeAccount           *string      `json:"feeAccount"`
-	InnerSignatures      []*string    `json:"innerSignatures"`
-	NewMaxFee            *string      `json:"newMaxFee"`
-	Operations           []*Operation `json:"operations"`
+	TransactionHash      string         `json:"transactionHash"`
+	LedgerSequence       int            `json:"ledgerSequence"`
+	ApplicationOrder     int            `json:"applicationOrder"`
+	Account              string         `json:"account"`
+	AccountSequence      string         `json:"accountSequence"`
+	MaxFee               string         `json:"maxFee"`
+	OperationCount       int            `json:"operationCount"`
+	CreatedAt            *string        `json:"createdAt"`
+	UpdatedAt            *string        `json:"updatedAt"`
+	ID                   *string        `json:"id"`
+	TxEnvelope           string         `json:"txEnvelope"`
+	TxResult             string         `json:"txResult"`
+	TxMeta               string         `json:"txMeta"`
+	TxFeeMeta            string         `json:"txFeeMeta"`
+	Signatures           []string       `json:"signatures"`
+	MemoType             string         `json:"memoType"`
+	Memo                 *string        `json:"memo"`
+	TimeBounds           []*int         `json:"timeBounds"`
+	Preconditions        *Preconditions `json:"preconditions"`
+	Successful           *bool          `json:"successful"`
+	FeeCharged           *string        `json:"feeCharged"`
+	FeeBump              *FeeBumpInfo   `json:"feeBump"`
+	InnerTransaction     *Transaction   `json:"innerTransaction"`
+	InnerTransactionHash *string        `json:"innerTransactionHash"`
+	FeeAccount           *string        `json:"feeAccount"`
+	InnerSignatures      []*string      `json:"innerSignatures"`
+	NewMaxFee            *string        `json:"newMaxFee"`
+	Operations           []*Operation   `json:"operations"`
 }
 
 type AccountFilterOption string
@@ -175,6 +245,49 @@ func (e AccountFilterOption) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type AssetType string
+
+const (
+	AssetTypeNative           AssetType = "native"
+	AssetTypeCreditAlphanum4  AssetType = "credit_alphanum4"
+	AssetTypeCreditAlphanum12 AssetType = "credit_alphanum12"
+)
+
+var AllAssetType = []AssetType{
+	AssetTypeNative,
+	AssetTypeCreditAlphanum4,
+	AssetTypeCreditAlphanum12,
+}
+
+func (e AssetType) IsValid() bool {
+	switch e {
+	case AssetTypeNative, AssetTypeCreditAlphanum4, AssetTypeCreditAlphanum12:
+		return true
+	}
+	return false
+}
+
+func (e AssetType) String() string {
+	return string(e)
+}
+
+func (e *AssetType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AssetType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AssetType", str)
+	}
+	return nil
+}
+
+func (e AssetType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Order string
 
 const (
@@ -215,3 +328,50 @@ func (e *Order) UnmarshalGQL(v interface{}) error {
 func (e Order) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type SponsoredEntryType string
+
+const (
+	SponsoredEntryTypeAccount          SponsoredEntryType = "account"
+	SponsoredEntryTypeTrustline        SponsoredEntryType = "trustline"
+	SponsoredEntryTypeData             SponsoredEntryType = "data"
+	SponsoredEntryTypeSigner           SponsoredEntryType = "signer"
+	SponsoredEntryTypeClaimableBalance SponsoredEntryType = "claimableBalance"
+)
+
+var AllSponsoredEntryType = []SponsoredEntryType{
+	SponsoredEntryTypeAccount,
+	SponsoredEntryTypeTrustline,
+	SponsoredEntryTypeData,
+	SponsoredEntryTypeSigner,
+	SponsoredEntryTypeClaimableBalance,
+}
+
+func (e SponsoredEntryType) IsValid() bool {
+	switch e {
+	case SponsoredEntryTypeAccount, SponsoredEntryTypeTrustline, SponsoredEntryTypeData, SponsoredEntryTypeSigner, SponsoredEntryTypeClaimableBalance:
+		return true
+	}
+	return false
+}
+
+func (e SponsoredEntryType) String() string {
+	return string(e)
+}
+
+func (e *SponsoredEntryType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SponsoredEntryType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SponsoredEntryType", str)
+	}
+	return nil
+}
+
+func (e SponsoredEntryType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
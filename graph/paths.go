@@ -0,0 +1,465 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"sort"
+
+	"github.com/owenjacob/hubblegraphql/apierror"
+)
+
+// A candidate conversion found by the path finder. Amounts are in stroops and path holds the
+// intermediate assets in the order they are traded from source to destination.
+type paymentPath struct {
+	Source            asset
+	SourceAmount      int64
+	Destination       asset
+	DestinationAmount int64
+	Path              []asset
+}
+
+type liquidityPoolReserves struct {
+	Fee      int64
+	Assets   [2]asset
+	Reserves [2]int64
+}
+
+// Searches the order book and liquidity pools for payment paths. Offers are loaded lazily per
+// asset and kept for the lifetime of the finder, which only lives for one query.
+type pathFinder struct {
+	ctx       context.Context
+	store     OrderBookStore
+	maxOffers int
+	bids      map[string][]Offer
+	asks      map[string][]Offer
+	pools     map[string][]liquidityPoolReserves
+	// Expansions left before the search is abandoned
+	expansions    int
+	maxExpansions int
+}
+
+func newPathFinder(ctx context.Context, store OrderBookStore, maxOffers int, maxExpansions int) *pathFinder {
+	return &pathFinder{
+		ctx:           ctx,
+		store:         store,
+		maxOffers:     maxOffers,
+		bids:          map[string][]Offer{},
+		asks:          map[string][]Offer{},
+		pools:         map[string][]liquidityPoolReserves{},
+		expansions:    maxExpansions,
+		maxExpansions: maxExpansions,
+	}
+}
+
+// Called before each asset is expanded. Working out an asset's edges walks every offer loaded
+// for it, so the search stops at the operation's deadline and after a fixed number of
+// expansions rather than running on unbounded.
+func (p *pathFinder) expand() error {
+	if err := p.ctx.Err(); err != nil {
+		return err
+	}
+	if p.expansions <= 0 {
+		return apierror.Errorf(apierror.LimitExceeded, "Path search gave up after %d steps, try fewer hops or assets", p.maxExpansions)
+	}
+	p.expansions--
+	return nil
+}
+
+// Orders paths with the same amount and length by their assets, so results don't depend on
+// the order edges were visited in
+func comparePaths(a paymentPath, b paymentPath) bool {
+	if a.Source != b.Source {
+		return a.Source.String() < b.Source.String()
+	}
+	if a.Destination != b.Destination {
+		return a.Destination.String() < b.Destination.String()
+	}
+	for i := range a.Path {
+		if a.Path[i] != b.Path[i] {
+			return a.Path[i].String() < b.Path[i].String()
+		}
+	}
+	return false
+}
+
+// Finds every path of at most maxHops conversions that spends exactly amount of source
+func (p *pathFinder) strictSend(source asset, amount int64, destinations []asset, maxHops int) ([]paymentPath, error) {
+	targets := map[string]bool{}
+	for _, destination := range destinations {
+		targets[destination.String()] = true
+	}
+
+	paths := []paymentPath{}
+	visited := map[string]bool{source.String(): true}
+	var search func(current asset, currentAmount int64, hops []asset) error
+	search = func(current asset, currentAmount int64, hops []asset) error {
+		if len(hops) >= maxHops {
+			return nil
+		}
+		if err := p.expand(); err != nil {
+			return err
+		}
+		edges, err := p.sendEdges(current, currentAmount)
+		if err != nil {
+			return err
+		}
+		for _, edge := range edges {
+			key := edge.asset.String()
+			if visited[key] {
+				continue
+			}
+			next := append(append([]asset{}, hops...), edge.asset)
+			if targets[key] {
+				paths = append(paths, paymentPath{
+					Source:            source,
+					SourceAmount:      amount,
+					Destination:       edge.asset,
+					DestinationAmount: edge.amount,
+					Path:              next[:len(next)-1],
+				})
+			}
+			visited[key] = true
+			if err := search(edge.asset, edge.amount, next); err != nil {
+				return err
+			}
+			visited[key] = false
+		}
+		return nil
+	}
+	if err := search(source, amount, nil); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(paths, func(i, j int) bool {
+		if paths[i].DestinationAmount != paths[j].DestinationAmount {
+			return paths[i].DestinationAmount > paths[j].DestinationAmount
+		}
+		if len(paths[i].Path) != len(paths[j].Path) {
+			return len(paths[i].Path) < len(paths[j].Path)
+		}
+		return comparePaths(paths[i], paths[j])
+	})
+	return paths, nil
+}
+
+// Finds every path of at most maxHops conversions that delivers exactly amount of destination.
+// The search walks backwards from the destination so the source amount accumulates as it goes.
+func (p *pathFinder) strictReceive(sources []asset, destination asset, amount int64, maxHops int) ([]paymentPath, error) {
+	targets := map[string]bool{}
+	for _, source := range sources {
+		targets[source.String()] = true
+	}
+
+	paths := []paymentPath{}
+	visited := map[string]bool{destination.String(): true}
+	var search func(current asset, currentAmount int64, hops []asset) error
+	search = func(current asset, currentAmount int64, hops []asset) error {
+		if len(hops) >= maxHops {
+			return nil
+		}
+		if err := p.expand(); err != nil {
+			return err
+		}
+		edges, err := p.receiveEdges(current, currentAmount)
+		if err != nil {
+			return err
+		}
+		for _, edge := range edges {
+			key := edge.asset.String()
+			if visited[key] {
+				continue
+			}
+			next := append([]asset{edge.asset}, hops...)
+			if targets[key] {
+				paths = append(paths, paymentPath{
+					Source:            edge.asset,
+					SourceAmount:      edge.amount,
+					Destination:       destination,
+					DestinationAmount: amount,
+					Path:              next[1:],
+				})
+			}
+			visited[key] = true
+			if err := search(edge.asset, edge.amount, next); err != nil {
+				return err
+			}
+			visited[key] = false
+		}
+		return nil
+	}
+	if err := search(destination, amount, nil); err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(paths, func(i, j int) bool {
+		if paths[i].SourceAmount != paths[j].SourceAmount {
+			return paths[i].SourceAmount < paths[j].SourceAmount
+		}
+		if len(paths[i].Path) != len(paths[j].Path) {
+			return len(paths[i].Path) < len(paths[j].Path)
+		}
+		return comparePaths(paths[i], paths[j])
+	})
+	return paths, nil
+}
+
+type pathEdge struct {
+	asset  asset
+	amount int64
+}
+
+// Best amount of each asset that can be bought by selling amount of from. Each neighbour is
+// reached either through the order book or through a single pool, whichever pays more.
+func (p *pathFinder) sendEdges(from asset, amount int64) ([]pathEdge, error) {
+	offers, err := p.offers(p.bids, p.store.OffersBuying, from)
+	if err != nil {
+		return nil, err
+	}
+	edges := map[string]pathEdge{}
+	for key, book := range groupOffers(offers, func(o Offer) string { return o.SellingAsset }) {
+		to, err := decodeAssetXDR(key)
+		if err != nil {
+			continue
+		}
+		if bought, ok := sellToOffers(book, amount); ok {
+			edges[to.String()] = pathEdge{asset: to, amount: bought}
+		}
+	}
+
+	pools, err := p.liquidityPools(from)
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range pools {
+		in, out, ok := pool.sides(from)
+		if !ok {
+			continue
+		}
+		bought, ok := pool.sendAmount(in, out, amount)
+		key := pool.Assets[out].String()
+		if ok && bought > edges[key].amount {
+			edges[key] = pathEdge{asset: pool.Assets[out], amount: bought}
+		}
+	}
+	return sortedEdges(edges), nil
+}
+
+// Cheapest amount of each asset that has to be sold to buy amount of to
+func (p *pathFinder) receiveEdges(to asset, amount int64) ([]pathEdge, error) {
+	offers, err := p.offers(p.asks, p.store.OffersSelling, to)
+	if err != nil {
+		return nil, err
+	}
+	edges := map[string]pathEdge{}
+	for key, book := range groupOffers(offers, func(o Offer) string { return o.BuyingAsset }) {
+		from, err := decodeAssetXDR(key)
+		if err != nil {
+			continue
+		}
+		if cost, ok := buyFromOffers(book, amount); ok {
+			edges[from.String()] = pathEdge{asset: from, amount: cost}
+		}
+	}
+
+	pools, err := p.liquidityPools(to)
+	if err != nil {
+		return nil, err
+	}
+	for _, pool := range pools {
+		out, in, ok := pool.sides(to)
+		if !ok {
+			continue
+		}
+		cost, ok := pool.receiveAmount(in, out, amount)
+		key := pool.Assets[in].String()
+		if existing, found := edges[key]; ok && (!found || cost < existing.amount) {
+			edges[key] = pathEdge{asset: pool.Assets[in], amount: cost}
+		}
+	}
+	return sortedEdges(edges), nil
+}
+
+// Edges in asset order. Once the expansion budget runs out the paths found depend on the order
+// assets were expanded in, which has to be the same every time for results to be cacheable.
+func sortedEdges(edges map[string]pathEdge) []pathEdge {
+	sorted := make([]pathEdge, 0, len(edges))
+	for _, edge := range edges {
+		sorted = append(sorted, edge)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].asset.String() < sorted[j].asset.String()
+	})
+	return sorted
+}
+
+// Live offers with the given asset on one side, cheapest first
+func (p *pathFinder) offers(cache map[string][]Offer, load func(context.Context, string, int) ([]Offer, error), a asset) ([]Offer, error) {
+	key := a.String()
+	if offers, ok := cache[key]; ok {
+		return offers, nil
+	}
+
+	offers, err := load(p.ctx, a.xdr(), p.maxOffers)
+	if err != nil {
+		return nil, err
+	}
+	cache[key] = offers
+	return offers, nil
+}
+
+// Pools holding a, loaded once per asset like offers
+func (p *pathFinder) liquidityPools(a asset) ([]liquidityPoolReserves, error) {
+	key := a.String()
+	if pools, ok := p.pools[key]; ok {
+		return pools, nil
+	}
+
+	pools, err := p.store.LiquidityPools(p.ctx, a.xdr())
+	if err != nil {
+		return nil, err
+	}
+	parsed := []liquidityPoolReserves{}
+	for i := range pools {
+		if reserves, ok := parsePoolReserves(&pools[i]); ok {
+			parsed = append(parsed, reserves)
+		}
+	}
+	p.pools[key] = parsed
+	return parsed, nil
+}
+
+// Pool reserves are stored as JSON with each asset either in canonical form or as base64 XDR
+func parsePoolReserves(pool *LiquidityPool) (liquidityPoolReserves, bool) {
+	raw := []struct {
+		Asset   string      `json:"asset"`
+		Reserve json.Number `json:"reserve"`
+	}{}
+	if err := json.Unmarshal(pool.AssetReserves, &raw); err != nil || len(raw) != 2 {
+		return liquidityPoolReserves{}, false
+	}
+
+	reserves := liquidityPoolReserves{Fee: int64(pool.Fee)}
+	for i := range raw {
+		a, err := parseAsset(raw[i].Asset)
+		if err != nil {
+			if a, err = decodeAssetXDR(raw[i].Asset); err != nil {
+				return liquidityPoolReserves{}, false
+			}
+		}
+		reserve, err := raw[i].Reserve.Int64()
+		if err != nil || reserve <= 0 {
+			return liquidityPoolReserves{}, false
+		}
+		reserves.Assets[i] = a
+		reserves.Reserves[i] = reserve
+	}
+	return reserves, true
+}
+
+// Index of the side holding a and of the opposite side
+func (l liquidityPoolReserves) sides(a asset) (int, int, bool) {
+	switch a {
+	case l.Assets[0]:
+		return 0, 1, true
+	case l.Assets[1]:
+		return 1, 0, true
+	}
+	return 0, 0, false
+}
+
+// Constant product output for depositing amount into side in, after the pool fee in basis points
+func (l liquidityPoolReserves) sendAmount(in int, out int, amount int64) (int64, bool) {
+	feeFactor := big.NewInt(10000 - l.Fee)
+	amountAfterFee := new(big.Int).Mul(big.NewInt(amount), feeFactor)
+	numerator := new(big.Int).Mul(big.NewInt(l.Reserves[out]), amountAfterFee)
+	denominator := new(big.Int).Mul(big.NewInt(l.Reserves[in]), big.NewInt(10000))
+	denominator.Add(denominator, amountAfterFee)
+	return bigToAmount(numerator.Quo(numerator, denominator))
+}
+
+// Constant product deposit needed on side in to withdraw amount from side out
+func (l liquidityPoolReserves) receiveAmount(in int, out int, amount int64) (int64, bool) {
+	if amount >= l.Reserves[out] {
+		return 0, false
+	}
+	numerator := new(big.Int).Mul(big.NewInt(l.Reserves[in]), big.NewInt(amount))
+	numerator.Mul(numerator, big.NewInt(10000))
+	denominator := new(big.Int).Mul(big.NewInt(l.Reserves[out]-amount), big.NewInt(10000-l.Fee))
+	return bigToAmount(divCeil(numerator, denominator))
+}
+
+func groupOffers(offers []Offer, key func(Offer) string) map[string][]Offer {
+	groups := map[string][]Offer{}
+	for i := range offers {
+		groups[key(offers[i])] = append(groups[key(offers[i])], offers[i])
+	}
+	return groups
+}
+
+// Amount bought by selling exactly amount into offers sorted cheapest first. Offer prices are
+// the number of units of the buying asset the seller wants per unit they are selling.
+func sellToOffers(offers []Offer, amount int64) (int64, bool) {
+	remaining := big.NewInt(amount)
+	bought := new(big.Int)
+	for _, offer := range offers {
+		if offer.Pricen <= 0 || offer.Priced <= 0 {
+			continue
+		}
+		cost := divCeil(new(big.Int).Mul(big.NewInt(offer.Amount), big.NewInt(offer.Pricen)), big.NewInt(offer.Priced))
+		if remaining.Cmp(cost) >= 0 {
+			bought.Add(bought, big.NewInt(offer.Amount))
+			remaining.Sub(remaining, cost)
+		} else {
+			partial := new(big.Int).Mul(remaining, big.NewInt(offer.Priced))
+			bought.Add(bought, partial.Quo(partial, big.NewInt(offer.Pricen)))
+			remaining.SetInt64(0)
+		}
+		if remaining.Sign() == 0 {
+			break
+		}
+	}
+	if remaining.Sign() != 0 || bought.Sign() == 0 {
+		return 0, false
+	}
+	return bigToAmount(bought)
+}
+
+// Amount that has to be sold to buy exactly amount from offers sorted cheapest first
+func buyFromOffers(offers []Offer, amount int64) (int64, bool) {
+	remaining := amount
+	cost := new(big.Int)
+	for _, offer := range offers {
+		if offer.Pricen <= 0 || offer.Priced <= 0 {
+			continue
+		}
+		taken := offer.Amount
+		if taken > remaining {
+			taken = remaining
+		}
+		cost.Add(cost, divCeil(new(big.Int).Mul(big.NewInt(taken), big.NewInt(offer.Pricen)), big.NewInt(offer.Priced)))
+		remaining -= taken
+		if remaining == 0 {
+			break
+		}
+	}
+	if remaining != 0 {
+		return 0, false
+	}
+	return bigToAmount(cost)
+}
+
+func divCeil(numerator *big.Int, denominator *big.Int) *big.Int {
+	quotient, remainder := new(big.Int).QuoRem(numerator, denominator, new(big.Int))
+	if remainder.Sign() > 0 {
+		quotient.Add(quotient, big.NewInt(1))
+	}
+	return quotient
+}
+
+func bigToAmount(value *big.Int) (int64, bool) {
+	if !value.IsInt64() || value.Sign() <= 0 {
+		return 0, false
+	}
+	return value.Int64(), true
+}
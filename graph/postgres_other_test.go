@@ -0,0 +1,12 @@
+//go:build !unix
+
+package graph
+
+import (
+	"errors"
+	"os/exec"
+)
+
+func commandAsUnprivilegedUser(dir string) (func(name string, args ...string) *exec.Cmd, error) {
+	return nil, errors.New("running as root")
+}
@@ -0,0 +1,261 @@
+package graph
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// The Postgres the golden queries also run against, if any. HUBBLE_TEST_DATABASE_URL points
+// at an existing server, in which each run gets a schema of its own. Otherwise a throwaway
+// cluster is started with initdb and pg_ctl when they are installed.
+var testPostgres struct {
+	dsn  string
+	skip string
+	stop func()
+}
+
+func TestMain(m *testing.M) {
+	startPostgres()
+	code := m.Run()
+	if testPostgres.stop != nil {
+		testPostgres.stop()
+	}
+	os.Exit(code)
+}
+
+func startPostgres() {
+	if dsn := os.Getenv("HUBBLE_TEST_DATABASE_URL"); dsn != "" {
+		testPostgres.dsn = dsn
+		return
+	}
+
+	initdb, err := findPostgresBinary("initdb")
+	if err != nil {
+		testPostgres.skip = "no Postgres: set HUBBLE_TEST_DATABASE_URL or install initdb and pg_ctl"
+		return
+	}
+	pgCtl := filepath.Join(filepath.Dir(initdb), "pg_ctl")
+
+	// Kept short, the socket path has to fit in sun_path
+	dir, err := os.MkdirTemp("", "hubblepg")
+	if err != nil {
+		testPostgres.skip = "no Postgres: " + err.Error()
+		return
+	}
+	// initdb and postgres refuse to run as root, which is what CI containers usually are, so
+	// there the cluster is owned by an unprivileged user instead
+	command := exec.Command
+	if os.Geteuid() == 0 {
+		command, err = commandAsUnprivilegedUser(dir)
+		if err != nil {
+			os.RemoveAll(dir)
+			testPostgres.skip = "no Postgres: " + err.Error() + ", set HUBBLE_TEST_DATABASE_URL instead"
+			return
+		}
+	}
+	data := filepath.Join(dir, "data")
+	if out, err := command(initdb, "-D", data, "-U", "postgres", "-A", "trust", "--no-locale", "-E", "UTF8").CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		testPostgres.skip = fmt.Sprintf("no Postgres: initdb: %v\n%s", err, out)
+		return
+	}
+	// Only listens on a unix socket in dir, so parallel runs can't collide on a port
+	options := "-c listen_addresses='' -k " + dir + " -c fsync=off"
+	if out, err := command(pgCtl, "-D", data, "-o", options, "-l", filepath.Join(dir, "log"), "-w", "start").CombinedOutput(); err != nil {
+		os.RemoveAll(dir)
+		testPostgres.skip = fmt.Sprintf("no Postgres: pg_ctl start: %v\n%s", err, out)
+		return
+	}
+
+	testPostgres.dsn = "host=" + dir + " user=postgres dbname=postgres sslmode=disable"
+	testPostgres.stop = func() {
+		command(pgCtl, "-D", data, "-m", "immediate", "stop").Run()
+		os.RemoveAll(dir)
+	}
+}
+
+func findPostgresBinary(name string) (string, error) {
+	if path, err := exec.LookPath(name); err == nil {
+		return path, nil
+	}
+	// Debian and Ubuntu keep the server binaries out of PATH
+	matches, _ := filepath.Glob("/usr/lib/postgresql/*/bin/" + name)
+	if len(matches) > 0 {
+		return matches[len(matches)-1], nil
+	}
+	return "", errors.New(name + " not found")
+}
+
+// Connects to a fresh schema holding the Horizon tables and rows, dropped again once the test
+// is done. Skips the test when no Postgres is available. configure can adjust the pool, e.g.
+// to add a query tracer.
+func openTestPostgres(t *testing.T, rows *MemoryStore, configure ...func(*pgxpool.Config)) *pgxpool.Pool {
+	t.Helper()
+	if testPostgres.dsn == "" {
+		t.Skip(testPostgres.skip)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	suffix := make([]byte, 6)
+	rand.Read(suffix)
+	schema := "hubble_test_" + hex.EncodeToString(suffix)
+
+	admin, err := pgx.Connect(ctx, testPostgres.dsn)
+	if err != nil {
+		t.Fatalf("connecting to test Postgres: %v", err)
+	}
+	defer admin.Close(ctx)
+	if _, err := admin.Exec(ctx, "CREATE SCHEMA "+schema); err != nil {
+		t.Fatalf("creating schema: %v", err)
+	}
+	t.Cleanup(func() {
+		conn, err := pgx.Connect(context.Background(), testPostgres.dsn)
+		if err != nil {
+			return
+		}
+		defer conn.Close(context.Background())
+		conn.Exec(context.Background(), "DROP SCHEMA "+schema+" CASCADE")
+	})
+
+	config, err := pgxpool.ParseConfig(testPostgres.dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.ConnConfig.RuntimeParams["search_path"] = schema
+	for _, f := range configure {
+		f(config)
+	}
+	pool, err := pgxpool.NewWithConfig(ctx, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+
+	ddl, err := os.ReadFile(filepath.Join("testdata", "horizon_schema.sql"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pool.Exec(ctx, string(ddl)); err != nil {
+		t.Fatalf("applying schema: %v", err)
+	}
+	if err := insertRows(ctx, pool, rows); err != nil {
+		t.Fatalf("loading fixtures: %v", err)
+	}
+	return pool
+}
+
+// Horizon writes NULL rather than an empty string in its optional columns
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func nullBytes(b []byte) *string {
+	if b == nil {
+		return nil
+	}
+	s := string(b)
+	return &s
+}
+
+func nullInt(n int64) *int64 {
+	if n == 0 {
+		return nil
+	}
+	return &n
+}
+
+// Writes every row of the in-memory store into the Horizon tables
+func insertRows(ctx context.Context, db *pgxpool.Pool, m *MemoryStore) error {
+	batch := &pgx.Batch{}
+	for _, l := range m.HistoryLedgers {
+		batch.Queue(`INSERT INTO history_ledgers (sequence, ledger_hash, previous_ledger_hash, transaction_count,
+			operation_count, closed_at, created_at, updated_at, id, importer_version, total_coins, fee_pool, base_fee,
+			base_reserve, max_tx_set_size, protocol_version, ledger_header, successful_transaction_count,
+			failed_transaction_count) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)`,
+			l.Sequence, l.LedgerHash, nullString(l.PreviousLedgerHash), l.TransactionCount, l.OperationCount, l.ClosedAt,
+			l.CreatedAt, l.UpdatedAt, l.ID, l.ImporterVersion, l.TotalCoins, l.FeePool, l.BaseFee, l.BaseReserve,
+			l.MaxTxSetSize, l.ProtocolVersion, nullString(l.LedgerHeader), l.SuccessfulTransactionCount, l.FailedTransactionCount)
+	}
+	for _, tx := range m.HistoryTransactions {
+		var innerSignatures []string
+		if tx.InnerTransactionHash != "" {
+			innerSignatures = tx.InnerSignatures
+		}
+		batch.Queue(`INSERT INTO history_transactions (transaction_hash, ledger_sequence, application_order, account,
+			account_sequence, max_fee, operation_count, created_at, updated_at, id, tx_envelope, tx_result, tx_meta,
+			tx_fee_meta, signatures, memo_type, memo, time_bounds, successful, fee_charged, inner_transaction_hash,
+			fee_account, inner_signatures, new_max_fee) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13,
+			$14, $15, $16, $17, $18::text::int8range, $19, $20, $21, $22, $23, $24)`,
+			tx.TransactionHash, tx.LedgerSequence, tx.ApplicationOrder, tx.Account, tx.AccountSequence, tx.MaxFee,
+			tx.OperationCount, tx.CreatedAt, tx.UpdatedAt, tx.ID, tx.TxEnvelope, tx.TxResult, tx.TxMeta, tx.TxFeeMeta,
+			tx.Signatures, tx.MemoType, nullString(tx.Memo), nullString(tx.TimeBounds), tx.Successful, tx.FeeCharged,
+			nullString(tx.InnerTransactionHash), nullString(tx.FeeAccount), innerSignatures, nullInt(tx.NewMaxFee))
+	}
+	for _, op := range m.HistoryOperations {
+		batch.Queue(`INSERT INTO history_operations (id, transaction_id, application_order, type, details, source_account)
+			VALUES ($1, $2, $3, $4, $5::text::jsonb, $6)`,
+			op.ID, op.TransactionID, op.ApplicationOrder, op.Type, nullBytes(op.Details), op.SourceAccount)
+	}
+	for account, ids := range m.Participants {
+		batch.Queue(`INSERT INTO history_accounts (address) VALUES ($1)`, account)
+		for _, id := range ids {
+			batch.Queue(`INSERT INTO history_transaction_participants (history_transaction_id, history_account_id)
+				SELECT $1, id FROM history_accounts WHERE address = $2`, id, account)
+		}
+	}
+	for _, a := range m.Accounts {
+		batch.Queue(`INSERT INTO accounts (account_id, balance, buying_liabilities, selling_liabilities, sequence_number,
+			num_subentries, inflation_destination, flags, home_domain, master_weight, threshold_low, threshold_medium,
+			threshold_high, last_modified_ledger, sponsor, num_sponsored, num_sponsoring)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`,
+			a.AccountID, a.Balance, a.BuyingLiabilities, a.SellingLiabilities, a.SeqNum, a.Subentries, a.InflationDest,
+			a.Flags, a.HomeDomain, a.MasterWeight, a.Low, a.Medium, a.High, a.LastModified, nullString(a.Sponsor),
+			a.NumSponsored, a.NumSponsoring)
+	}
+	for _, tl := range m.AccountTrustLines {
+		batch.Queue(`INSERT INTO trust_lines (ledger_key, account_id, asset_type, asset_issuer, asset_code, balance,
+			trust_line_limit, buying_liabilities, selling_liabilities, flags, last_modified_ledger, sponsor)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+			tl.LedgerKey, tl.AccountID, tl.AssetType, tl.AssetIssuer, tl.AssetCode, tl.Balance, tl.TrustLineLimit,
+			tl.BuyingLiabilities, tl.SellingLiabilities, tl.Flags, tl.LastModifiedLedger, nullString(tl.Sponsor))
+	}
+	for _, s := range m.AccountSigners {
+		batch.Queue(`INSERT INTO accounts_signers (account_id, signer, weight, sponsor) VALUES ($1, $2, $3, $4)`,
+			s.AccountID, s.Signer, s.Weight, nullString(s.Sponsor))
+	}
+	for _, d := range m.AccountData {
+		batch.Queue(`INSERT INTO accounts_data (ledger_key, account_id, name, value, last_modified_ledger, sponsor)
+			VALUES ($1, $2, $3, $4, $5, $6)`,
+			d.LedgerKey, d.AccountID, d.Name, d.Value, d.LastModified, nullString(d.Sponsor))
+	}
+	for _, c := range m.ClaimableBalances {
+		batch.Queue(`INSERT INTO claimable_balances (id, sponsor) VALUES ($1, $2)`, c.ID, nullString(c.Sponsor))
+	}
+	for _, o := range m.Offers {
+		batch.Queue(`INSERT INTO offers (seller_id, offer_id, selling_asset, buying_asset, amount, pricen, priced, price,
+			flags, last_modified_ledger, sponsor) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+			o.SellerID, o.OfferID, o.SellingAsset, o.BuyingAsset, o.Amount, o.Pricen, o.Priced, o.Price, o.Flags,
+			o.LastModifiedLedger, nullString(o.Sponsor))
+	}
+	for _, p := range m.Pools {
+		batch.Queue(`INSERT INTO liquidity_pools (id, type, fee, trustline_count, share_count, asset_reserves,
+			last_modified_ledger) VALUES ($1, $2, $3, $4, $5, $6::text::jsonb, $7)`,
+			p.ID, p.Type, p.Fee, p.TrustlineCount, p.ShareCount, string(p.AssetReserves), p.LastModifiedLedger)
+	}
+	return db.SendBatch(ctx, batch).Close()
+}
@@ -0,0 +1,43 @@
+//go:build unix
+
+package graph
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"os/user"
+	"strconv"
+	"syscall"
+)
+
+// Hands dir to the postgres user, or nobody when there isn't one, and returns a constructor
+// for commands that run as that user
+func commandAsUnprivilegedUser(dir string) (func(name string, args ...string) *exec.Cmd, error) {
+	account, err := user.Lookup("postgres")
+	if err != nil {
+		account, err = user.Lookup("nobody")
+	}
+	if err != nil {
+		return nil, errors.New("running as root with neither a postgres nor a nobody user")
+	}
+	uid, err := strconv.Atoi(account.Uid)
+	if err != nil {
+		return nil, err
+	}
+	gid, err := strconv.Atoi(account.Gid)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chown(dir, uid, gid); err != nil {
+		return nil, err
+	}
+
+	return func(name string, args ...string) *exec.Cmd {
+		cmd := exec.Command(name, args...)
+		// The user may not be able to enter the package directory
+		cmd.Dir = dir
+		cmd.SysProcAttr = &syscall.SysProcAttr{Credential: &syscall.Credential{Uid: uint32(uid), Gid: uint32(gid)}}
+		return cmd
+	}, nil
+}
@@ -2,11 +2,18 @@ package graph
 
 //go:generate go run github.com/99designs/gqlgen
 import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
-	"github.com/jinzhu/gorm"
-	"github.com/jinzhu/gorm/dialects/postgres"
-	"github.com/lib/pq"
+	"github.com/owenjacob/hubblegraphql/apierror"
 	"github.com/owenjacob/hubblegraphql/graph/model"
 )
 
@@ -15,155 +22,592 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	DB *gorm.DB
+	Stores Stores
+	Limits Limits
 }
 
-// Limit when search for unconstrained data
-var maxSearchLimit int = 500
+// Bounds on how much data a single query may request
+type Limits struct {
+	// Limit when search for unconstrained data
+	MaxSearchLimit int
+	// Network constrained number of transactions per ledger
+	MaxTxnsLimit int
+	// Network constrained number of operations per transaction
+	MaxOpsLimit int
+	// Longest chain of conversions searched when finding payment paths
+	MaxPathHops int
+	// Offers loaded per asset and side of the order book when finding payment paths
+	MaxOrderBookOffers int
+	// Assets a single path search may expand before it gives up
+	MaxPathExpansions int
+	// Most recent ledgers that fee statistics can be computed over
+	MaxFeeStatsLedgers int
+	// Deepest nesting of fields allowed in a single operation
+	MaxQueryDepth int
+	// Total cost allowed for a single operation, see Complexity
+	MaxQueryComplexity int
+}
 
-// Network constrained number of transactions per ledger
-var maxTxnsLimit int = 105
+var DefaultLimits = Limits{
+	MaxSearchLimit:     500,
+	MaxTxnsLimit:       105,
+	MaxOpsLimit:        100,
+	MaxPathHops:        4,
+	MaxOrderBookOffers: 5000,
+	MaxPathExpansions:  2000,
+	MaxFeeStatsLedgers: 100,
+	MaxQueryDepth:      10,
+	MaxQueryComplexity: 100000,
+}
 
-// Network constrained number of operations per transaction
-var maxOpsLimit int = 100
+// Postgres rejects a negative limit
+var errNegativeLimit = apierror.New(apierror.InvalidArgument, "limit cannot be negative")
 
+func checkLimit(limit int, max int) error {
+	if limit < 0 {
+		return errNegativeLimit
+	}
+	if limit > max {
+		return apierror.Errorf(apierror.LimitExceeded, "Maximum limit is %d", max)
+	}
+	return nil
+}
+
+// Account flags are a bit set: auth required, auth revocable, auth immutable
 func parseAccountFlags(flags int) *model.Flags {
-	switch flags {
-	case 1:
-		return &model.Flags{
-			AuthImmutable: false,
-			AuthRequired:  true,
-			AuthRevocable: false,
+	return &model.Flags{
+		AuthRequired:  flags&1 != 0,
+		AuthRevocable: flags&2 != 0,
+		AuthImmutable: flags&4 != 0,
+	}
+}
+
+func parseOperation(operation *HistoryOperations) *model.Operation {
+	detail, _ := operation.Details.MarshalJSON()
+	details := string(detail)
+	return &model.Operation{
+		ID:               strconv.FormatInt(operation.ID, 10),
+		TransactionID:    strconv.FormatInt(operation.TransactionID, 10),
+		ApplicationOrder: operation.ApplicationOrder,
+		Type:             operation.Type,
+		Details:          &details,
+		SourceAccount:    operation.SourceAccount,
+	}
+}
+
+// Assets an account can hold: native plus each of its trust lines, with the balance of each
+func (r *Resolver) accountAssets(ctx context.Context, accountID string) ([]asset, map[string]int64, error) {
+	account, err := r.Stores.Accounts.Account(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if account == nil {
+		return nil, nil, apierror.New(apierror.NotFound, "Account not found")
+	}
+
+	trustLines, err := r.Stores.Accounts.TrustLines(ctx, accountID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	assets := []asset{nativeAsset}
+	balances := map[string]int64{nativeAsset.String(): account.Balance}
+	for i := range trustLines {
+		if trustLines[i].AssetType == assetTypePoolShare {
+			continue
 		}
-	case 3:
-		return &model.Flags{
-			AuthImmutable: false,
-			AuthRequired:  true,
-			AuthRevocable: true,
+		a := asset{Type: trustLines[i].AssetType, Code: trustLines[i].AssetCode, Issuer: trustLines[i].AssetIssuer}
+		assets = append(assets, a)
+		balances[a.String()] = trustLines[i].Balance
+	}
+	return assets, balances, nil
+}
+
+func parseAssets(canonical []string) ([]asset, error) {
+	assets := make([]asset, 0, len(canonical))
+	for i := range canonical {
+		a, err := parseAsset(canonical[i])
+		if err != nil {
+			return nil, err
 		}
-	default:
-		return &model.Flags{
-			AuthImmutable: false,
-			AuthRequired:  false,
-			AuthRevocable: false,
+		assets = append(assets, a)
+	}
+	return assets, nil
+}
+
+func parsePaths(paths []paymentPath, limit int) []*model.Path {
+	if len(paths) > limit {
+		paths = paths[:limit]
+	}
+
+	parsed := make([]*model.Path, 0, len(paths))
+	for i := range paths {
+		intermediates := make([]*model.Asset, 0, len(paths[i].Path))
+		for j := range paths[i].Path {
+			intermediates = append(intermediates, paths[i].Path[j].model())
 		}
+		parsed = append(parsed, &model.Path{
+			SourceAsset:       paths[i].Source.model(),
+			SourceAmount:      formatAmount(paths[i].SourceAmount),
+			DestinationAsset:  paths[i].Destination.model(),
+			DestinationAmount: formatAmount(paths[i].DestinationAmount),
+			Path:              intermediates,
+		})
 	}
+	return parsed
+}
+
+// Minimum balance in stroops: two base reserves for the account itself plus one per subentry,
+// adjusted for the reserves this account pays for others or has paid by its sponsor. Reserves
+// are priced from the latest ledger's base reserve, so there is no minimum balance, and ok is
+// false, until a ledger has been ingested.
+func (r *Resolver) accountMinimumBalance(ctx context.Context, account *model.Account) (minimum int64, ok bool, err error) {
+	latestLedger, err := r.Stores.Ledgers.LatestLedger(ctx)
+	if err != nil || latestLedger == nil {
+		return 0, false, err
+	}
+	reserves := int64(2 + account.SubentryCount + account.NumSponsoring - account.NumSponsored)
+	return reserves * int64(latestLedger.BaseReserve), true, nil
+}
+
+// Decimal form of a stroop amount. Done in integers since a float64 can't hold large balances
+// and ledger totals to the stroop.
+func formatAmount(stroops int64) string {
+	sign := ""
+	magnitude := uint64(stroops)
+	if stroops < 0 {
+		sign = "-"
+		magnitude = -magnitude
+	}
+	return fmt.Sprintf("%s%d.%07d", sign, magnitude/10000000, magnitude%10000000)
+}
+
+// Columns Horizon leaves NULL are read as empty strings, and come back out as null
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func parseLedger(ledger *HistoryLedgers) *model.Ledger {
+	createdAt := ledger.CreatedAt.Format("2006-01-02 15:04:05")
+	updatedAt := ledger.UpdatedAt.Format("2006-01-02 15:04:05")
+	id := int(ledger.ID)
+	return &model.Ledger{
+		Sequence:                   ledger.Sequence,
+		LedgerHash:                 ledger.LedgerHash,
+		PreviousLedgerHash:         optionalString(ledger.PreviousLedgerHash),
+		TransactionCount:           ledger.TransactionCount,
+		OperationCount:             ledger.OperationCount,
+		ClosedAt:                   ledger.ClosedAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:                  &createdAt,
+		UpdatedAt:                  &updatedAt,
+		ID:                         &id,
+		ImporterVersion:            ledger.ImporterVersion,
+		TotalCoins:                 formatAmount(ledger.TotalCoins),
+		FeePool:                    formatAmount(ledger.FeePool),
+		BaseFee:                    ledger.BaseFee,
+		BaseReserve:                ledger.BaseReserve,
+		MaxTxSetSize:               ledger.MaxTxSetSize,
+		ProtocolVersion:            ledger.ProtocolVersion,
+		LedgerHeader:               optionalString(ledger.LedgerHeader),
+		SuccessfulTransactionCount: &ledger.SuccessfulTransactionCount,
+		FailedTransactionCount:     &ledger.FailedTransactionCount,
+	}
+}
+
+// Memo is null for transactions without one, and the fee bump fields for transactions that
+// aren't fee bumps. A fee bump is seen from the outside, as Horizon does, with its inner
+// transaction seen from the inside.
+func parseTransaction(transaction *HistoryTransactions) *model.Transaction {
+	createdAt := transaction.CreatedAt.Format("2006-01-02 15:04:05")
+	updatedAt := transaction.UpdatedAt.Format("2006-01-02 15:04:05")
+	id := strconv.FormatInt(transaction.ID, 10)
+	feeCharged := formatAmount(transaction.FeeCharged)
+
+	parsed := &model.Transaction{
+		TransactionHash:  transaction.TransactionHash,
+		LedgerSequence:   transaction.LedgerSequence,
+		ApplicationOrder: transaction.ApplicationOrder,
+		Account:          transaction.Account,
+		AccountSequence:  strconv.FormatInt(transaction.AccountSequence, 10),
+		MaxFee:           formatAmount(transaction.MaxFee),
+		OperationCount:   transaction.OperationCount,
+		CreatedAt:        &createdAt,
+		UpdatedAt:        &updatedAt,
+		ID:               &id,
+		TxEnvelope:       transaction.TxEnvelope,
+		TxResult:         transaction.TxResult,
+		TxMeta:           transaction.TxMeta,
+		TxFeeMeta:        transaction.TxFeeMeta,
+		Signatures:       transaction.Signatures,
+		MemoType:         transaction.MemoType,
+		TimeBounds:       parseTimeBounds(transaction.TimeBounds),
+		Successful:       &transaction.Successful,
+		FeeCharged:       &feeCharged,
+	}
+	if transaction.MemoType != "none" {
+		parsed.Memo = &transaction.Memo
+	}
+	if transaction.InnerTransactionHash == "" {
+		return parsed
+	}
+
+	newMaxFee := strconv.FormatInt(transaction.NewMaxFee, 10)
+	parsed.InnerTransactionHash = &transaction.InnerTransactionHash
+	parsed.FeeAccount = &transaction.FeeAccount
+	parsed.NewMaxFee = &newMaxFee
+	parsed.InnerSignatures = make([]*string, 0, len(transaction.InnerSignatures))
+	for i := range transaction.InnerSignatures {
+		parsed.InnerSignatures = append(parsed.InnerSignatures, &transaction.InnerSignatures[i])
+	}
+	parsed.FeeBump = &model.FeeBumpInfo{
+		TransactionHash: transaction.TransactionHash,
+		FeeAccount:      transaction.FeeAccount,
+		MaxFee:          formatAmount(transaction.NewMaxFee),
+		Signatures:      transaction.Signatures,
+	}
+
+	// The row's max fee is the inner transaction's, the fee bump bids the new max fee. Seen
+	// from the inside the transaction is a plain one, so none of the fee bump's fields apply.
+	inner := *parsed
+	inner.TransactionHash = transaction.InnerTransactionHash
+	inner.Signatures = transaction.InnerSignatures
+	inner.FeeBump = nil
+	inner.InnerTransactionHash = nil
+	inner.FeeAccount = nil
+	inner.InnerSignatures = nil
+	inner.NewMaxFee = nil
+	parsed.MaxFee = parsed.FeeBump.MaxFee
+	parsed.InnerTransaction = &inner
+	return parsed
+}
+
+// Time bounds come from the time_bounds column, already parsed into the transaction's
+// timeBounds, and everything else from the envelope
+func parsePreconditions(transaction *model.Transaction) (*model.Preconditions, error) {
+	conditions, err := parseEnvelopePreconditions(transaction.TxEnvelope)
+	if err != nil {
+		return nil, fmt.Errorf("reading the envelope of transaction %s: %w", transaction.TransactionHash, err)
+	}
+	if conditions == nil && transaction.TimeBounds == nil {
+		return nil, nil
+	}
+
+	parsed := &model.Preconditions{ExtraSigners: []string{}}
+	if len(transaction.TimeBounds) == 2 {
+		parsed.TimeBounds = &model.TimeBounds{}
+		// A min time of 0 is no bound at all
+		if minTime := transaction.TimeBounds[0]; minTime != nil && *minTime != 0 {
+			unix := int64(*minTime)
+			at := time.Unix(unix, 0).UTC()
+			parsed.TimeBounds.MinTime = &at
+			parsed.TimeBounds.MinTimeUnix = &unix
+		}
+		if maxTime := transaction.TimeBounds[1]; maxTime != nil {
+			unix := int64(*maxTime)
+			at := time.Unix(unix, 0).UTC()
+			parsed.TimeBounds.MaxTime = &at
+			parsed.TimeBounds.MaxTimeUnix = &unix
+		}
+	}
+	if conditions == nil {
+		return parsed, nil
+	}
+
+	if conditions.LedgerBounds != nil {
+		parsed.LedgerBounds = &model.LedgerBounds{MinLedger: int(conditions.LedgerBounds[0])}
+		if conditions.LedgerBounds[1] != 0 {
+			maxLedger := int(conditions.LedgerBounds[1])
+			parsed.LedgerBounds.MaxLedger = &maxLedger
+		}
+	}
+	parsed.MinAccountSequence = conditions.MinSequence
+	if conditions.MinSequenceAge != 0 {
+		age := int64(math.MaxInt64)
+		if conditions.MinSequenceAge < math.MaxInt64 {
+			age = int64(conditions.MinSequenceAge)
+		}
+		parsed.MinAccountSequenceAge = &age
+	}
+	if conditions.MinSequenceLedgerGap != 0 {
+		gap := int(conditions.MinSequenceLedgerGap)
+		parsed.MinAccountSequenceLedgerGap = &gap
+	}
+	if conditions.ExtraSigners != nil {
+		parsed.ExtraSigners = conditions.ExtraSigners
+	}
+	return parsed, nil
+}
+
+// time_bounds is an int8range such as [100,200) holding the min and max time, read as its text
+// form. Either end is null when it is unbounded, and there are no bounds at all when the
+// column is NULL.
+func parseTimeBounds(timeBounds string) []*int {
+	if len(timeBounds) < 3 || timeBounds == "empty" {
+		return nil
+	}
+	lower, upper, ok := strings.Cut(timeBounds[1:len(timeBounds)-1], ",")
+	if !ok {
+		return nil
+	}
+
+	bounds := make([]*int, 2)
+	if lower != "" {
+		minTime, err := strconv.Atoi(lower)
+		if err != nil {
+			return nil
+		}
+		// Postgres only writes inclusive lower bounds, but be safe
+		if timeBounds[0] == '(' {
+			minTime++
+		}
+		bounds[0] = &minTime
+	}
+	if upper != "" {
+		maxTime, err := strconv.Atoi(upper)
+		if err != nil {
+			return nil
+		}
+		if timeBounds[len(timeBounds)-1] == ']' {
+			maxTime++
+		}
+		bounds[1] = &maxTime
+	}
+	return bounds
+}
+
+// Data entry values are stored base64 encoded and may hold arbitrary bytes, so the decoded
+// text is only returned when it is valid UTF-8. Binary values can be read through hex instead.
+func parseAccountData(accountData *AccountData) *model.Data {
+	data := &model.Data{
+		Name:               &accountData.Name,
+		RawValue:           accountData.Value,
+		LastModifiedLedger: accountData.LastModified,
+	}
+	if accountData.Sponsor != "" {
+		data.Sponsor = &accountData.Sponsor
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(accountData.Value)
+	if err != nil {
+		return data
+	}
+	hexValue := hex.EncodeToString(decoded)
+	byteLength := len(decoded)
+	data.Hex = &hexValue
+	data.ByteLength = &byteLength
+	if utf8.Valid(decoded) {
+		value := string(decoded)
+		data.Value = &value
+	}
+	return data
+}
+
+// Escapes the LIKE wildcards so user input is only ever matched literally
+func escapeLike(pattern string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(pattern)
+}
+
+// Entries by type, then in store order. The stores return up to limit of each type, so the
+// first limit of the merged list are the same whichever types they come from.
+func parseSponsoredEntries(sponsored *SponsoredEntries, limit int) []*model.SponsoredEntry {
+	entries := []*model.SponsoredEntry{}
+	for i := range sponsored.Accounts {
+		entries = append(entries, &model.SponsoredEntry{
+			Type:    model.SponsoredEntryTypeAccount,
+			Account: &sponsored.Accounts[i].AccountID,
+			Sponsor: sponsored.Accounts[i].Sponsor,
+			Key:     sponsored.Accounts[i].AccountID,
+		})
+	}
+	for i := range sponsored.TrustLines {
+		entries = append(entries, &model.SponsoredEntry{
+			Type:    model.SponsoredEntryTypeTrustline,
+			Account: &sponsored.TrustLines[i].AccountID,
+			Sponsor: sponsored.TrustLines[i].Sponsor,
+			Key:     sponsored.TrustLines[i].AssetCode + ":" + sponsored.TrustLines[i].AssetIssuer,
+		})
+	}
+	for i := range sponsored.Data {
+		entries = append(entries, &model.SponsoredEntry{
+			Type:    model.SponsoredEntryTypeData,
+			Account: &sponsored.Data[i].AccountID,
+			Sponsor: sponsored.Data[i].Sponsor,
+			Key:     sponsored.Data[i].Name,
+		})
+	}
+	for i := range sponsored.Signers {
+		entries = append(entries, &model.SponsoredEntry{
+			Type:    model.SponsoredEntryTypeSigner,
+			Account: &sponsored.Signers[i].AccountID,
+			Sponsor: sponsored.Signers[i].Sponsor,
+			Key:     sponsored.Signers[i].Signer,
+		})
+	}
+	for i := range sponsored.ClaimableBalances {
+		entries = append(entries, &model.SponsoredEntry{
+			Type:    model.SponsoredEntryTypeClaimableBalance,
+			Sponsor: sponsored.ClaimableBalances[i].Sponsor,
+			Key:     sponsored.ClaimableBalances[i].ID,
+		})
+	}
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
 }
 
 type Account struct {
-	AccountID          string `gorm:"column:account_id"`
-	Balance            int64  `gorm:"column:balance"`
-	BuyingLiabilities  int64  `gorm:"column:buying_liabilities"`
-	SellingLiabilities int64  `gorm:"column:selling_liabilities"`
-	SeqNum             int64  `gorm:"column:sequence_number"`
-	Subentries         int    `gorm:"column:num_subentries"`
-	InflationDest      string `gorm:"column:inflation_destination"`
-	Flags              int    `gorm:"column:flags"`
-	HomeDomain         string `gorm:"column:home_domain"`
-	MasterWeight       int    `gorm:"column:master_weight"`
-	Low                int    `gorm:"column:threshold_low"`
-	Medium             int    `gorm:"column:threshold_medium"`
-	High               int    `gorm:"column:threshold_high"`
-	LastModified       int    `gorm:"column:last_modified_ledger"`
+	AccountID          string `db:"account_id"`
+	Balance            int64  `db:"balance"`
+	BuyingLiabilities  int64  `db:"buying_liabilities"`
+	SellingLiabilities int64  `db:"selling_liabilities"`
+	SeqNum             int64  `db:"sequence_number"`
+	Subentries         int    `db:"num_subentries"`
+	InflationDest      string `db:"inflation_destination"`
+	Flags              int    `db:"flags"`
+	HomeDomain         string `db:"home_domain"`
+	MasterWeight       int    `db:"master_weight"`
+	Low                int    `db:"threshold_low"`
+	Medium             int    `db:"threshold_medium"`
+	High               int    `db:"threshold_high"`
+	LastModified       int    `db:"last_modified_ledger"`
+	Sponsor            string `db:"sponsor"`
+	NumSponsored       int    `db:"num_sponsored"`
+	NumSponsoring      int    `db:"num_sponsoring"`
 }
 
 type AccountData struct {
-	LedgerKey    string `gorm:"column:ledger_key"`
-	AccountID    string `gorm:"column:account_id"`
-	Name         string `gorm:"column:name"`
-	Value        string `gorm:"column:value"`
-	LastModified int    `gorm:"column:last_modified_ledger"`
+	LedgerKey    string `db:"ledger_key"`
+	AccountID    string `db:"account_id"`
+	Name         string `db:"name"`
+	Value        string `db:"value"`
+	LastModified int    `db:"last_modified_ledger"`
+	Sponsor      string `db:"sponsor"`
 }
 
 type AccountSigner struct {
-	AccountID string `gorm:"column:account_id"`
-	Signer    string `gorm:"column:signer"`
-	Weight    int    `gorm:"column:weight"`
+	AccountID string `db:"account_id"`
+	Signer    string `db:"signer"`
+	Weight    int    `db:"weight"`
+	Sponsor   string `db:"sponsor"`
+}
+
+type ClaimableBalance struct {
+	ID      string `db:"id"`
+	Sponsor string `db:"sponsor"`
 }
 
 type HistoryAccounts struct {
-	ID      int64  `gorm:"column:id"`
-	Address string `gorm:"column:address"`
+	ID      int64  `db:"id"`
+	Address string `db:"address"`
 }
 
 type HistoryLedgers struct {
-	Sequence                   int       `gorm:"column:sequence"`
-	LedgerHash                 string    `gorm:"column:ledger_hash"`
-	PreviousLedgerHash         string    `gorm:"column:previous_ledger_hash"`
-	TransactionCount           int       `gorm:"column:transaction_count"`
-	OperationCount             int       `gorm:"column: operation_count"`
-	ClosedAt                   time.Time `gorm:"column:closed_at"`
-	CreatedAt                  time.Time `gorm:"column:created_at"`
-	UpdatedAt                  time.Time `gorm:"column:updated_at"`
-	ID                         int64     `gorm:"column:id"`
-	ImporterVersion            int       `gorm:"column:importer_version"`
-	TotalCoins                 int64     `gorm:"column:total_coins"`
-	FeePool                    int64     `gorm:"column:fee_pool"`
-	BaseFee                    int       `gorm:"column:base_fee"`
-	BaseReserve                int       `gorm:"column:base_reserve"`
-	MaxTxSetSize               int       `gorm:"column:max_tx_set_size"`
-	ProtocolVersion            int       `gorm:"column:protocol_version"`
-	LedgerHeader               string    `gorm:"column:ledger_header"`
-	SuccessfulTransactionCount int       `gorm:"column:successful_transaction_count"`
-	FailedTransactionCount     int       `gorm:"column:failed_transaction_count"`
+	Sequence                   int       `db:"sequence"`
+	LedgerHash                 string    `db:"ledger_hash"`
+	PreviousLedgerHash         string    `db:"previous_ledger_hash"`
+	TransactionCount           int       `db:"transaction_count"`
+	OperationCount             int       `db:"operation_count"` // gorm's "column: operation_count" had a stray space and never loaded
+	ClosedAt                   time.Time `db:"closed_at"`
+	CreatedAt                  time.Time `db:"created_at"`
+	UpdatedAt                  time.Time `db:"updated_at"`
+	ID                         int64     `db:"id"`
+	ImporterVersion            int       `db:"importer_version"`
+	TotalCoins                 int64     `db:"total_coins"`
+	FeePool                    int64     `db:"fee_pool"`
+	BaseFee                    int       `db:"base_fee"`
+	BaseReserve                int       `db:"base_reserve"`
+	MaxTxSetSize               int       `db:"max_tx_set_size"`
+	ProtocolVersion            int       `db:"protocol_version"`
+	LedgerHeader               string    `db:"ledger_header"`
+	SuccessfulTransactionCount int       `db:"successful_transaction_count"`
+	FailedTransactionCount     int       `db:"failed_transaction_count"`
 }
 
 type HistoryOperationParticipants struct {
-	HistoryOperationID int64 `gorm:"column:history_operation_id"`
-	HistoryAccountID   int64 `gorm:"column:history_account_id"`
+	HistoryOperationID int64 `db:"history_operation_id"`
+	HistoryAccountID   int64 `db:"history_account_id"`
 }
 
 type HistoryOperations struct {
-	ID               int64          `gorm:"column:id"`
-	TransactionID    int64          `gorm:"column:transaction_id"`
-	ApplicationOrder int            `gorm:"column:application_order"`
-	Type             int            `gorm:"column:type"`
-	Details          postgres.Jsonb `gorm:"column:details"`
-	SourceAccount    string         `gorm:"column:source_account"`
+	ID               int64           `db:"id"`
+	TransactionID    int64           `db:"transaction_id"`
+	ApplicationOrder int             `db:"application_order"`
+	Type             int             `db:"type"`
+	Details          json.RawMessage `db:"details"`
+	SourceAccount    string          `db:"source_account"`
 }
 
 type HistoryTransactionParticipants struct {
-	HistoryTransactionID int64 `gorm:"column:history_transaction_id"`
-	HistoryAccountID     int64 `gorm:"column:history_account_id"`
+	HistoryTransactionID int64 `db:"history_transaction_id"`
+	HistoryAccountID     int64 `db:"history_account_id"`
 }
 
 type HistoryTransactions struct {
-	TransactionHash      string         `gorm:"column:transaction_hash"`
-	LedgerSequence       int            `gorm:"column:ledger_sequence"`
-	ApplicationOrder     int            `gorm:"column:application_order"`
-	Account              string         `gorm:"column:account"`
-	AccountSequence      int64          `gorm:"column:account_sequence"`
-	MaxFee               int64          `gorm:"column:max_fee"`
-	OperationCount       int            `gorm:"column:operation_count"`
-	CreatedAt            time.Time      `gorm:"column:created_at"`
-	UpdatedAt            time.Time      `gorm:"column:updated_at"`
-	ID                   int64          `gorm:"column:id"`
-	TxEnvelope           string         `gorm:"column:tx_envelope"`
-	TxResult             string         `gorm:"column:tx_result"`
-	TxMeta               string         `gorm:"column:tx_meta"`
-	TxFeeMeta            string         `gorm:"column:tx_fee_meta"`
-	Signatures           pq.StringArray `gorm:"column:signatures"`
-	MemoType             string         `gorm:"column:memo_type"`
-	Memo                 string         `gorm:"column:memo"`
-	TimeBounds           []uint8        `gorm:"column:time_bounds"`
-	Successful           bool           `gorm:"column:successful"`
-	FeeCharged           int64          `gorm:"column:fee_charged"`
-	InnerTransactionHash string         `gorm:"column:inner_transaction_hash"`
-	FeeAccount           string         `gorm:"column:fee_account"`
-	InnerSignatures      pq.StringArray `gorm:"column:inner_signatures"`
-	NewMaxFee            int64          `gorm:"column:new_max_fee"`
+	TransactionHash      string    `db:"transaction_hash"`
+	LedgerSequence       int       `db:"ledger_sequence"`
+	ApplicationOrder     int       `db:"application_order"`
+	Account              string    `db:"account"`
+	AccountSequence      int64     `db:"account_sequence"`
+	MaxFee               int64     `db:"max_fee"`
+	OperationCount       int       `db:"operation_count"`
+	CreatedAt            time.Time `db:"created_at"`
+	UpdatedAt            time.Time `db:"updated_at"`
+	ID                   int64     `db:"id"`
+	TxEnvelope           string    `db:"tx_envelope"`
+	TxResult             string    `db:"tx_result"`
+	TxMeta               string    `db:"tx_meta"`
+	TxFeeMeta            string    `db:"tx_fee_meta"`
+	Signatures           []string  `db:"signatures"`
+	MemoType             string    `db:"memo_type"`
+	Memo                 string    `db:"memo"`
+	TimeBounds           string    `db:"time_bounds"`
+	Successful           bool      `db:"successful"`
+	FeeCharged           int64     `db:"fee_charged"`
+	InnerTransactionHash string    `db:"inner_transaction_hash"`
+	FeeAccount           string    `db:"fee_account"`
+	InnerSignatures      []string  `db:"inner_signatures"`
+	NewMaxFee            int64     `db:"new_max_fee"`
+}
+
+type LiquidityPool struct {
+	ID                 string          `db:"id"`
+	Type               int             `db:"type"`
+	Fee                int             `db:"fee"`
+	TrustlineCount     int64           `db:"trustline_count"`
+	ShareCount         int64           `db:"share_count"`
+	AssetReserves      json.RawMessage `db:"asset_reserves"`
+	LastModifiedLedger int             `db:"last_modified_ledger"`
+}
+
+type Offer struct {
+	SellerID           string  `db:"seller_id"`
+	OfferID            int64   `db:"offer_id"`
+	SellingAsset       string  `db:"selling_asset"`
+	BuyingAsset        string  `db:"buying_asset"`
+	Amount             int64   `db:"amount"`
+	Pricen             int64   `db:"pricen"`
+	Priced             int64   `db:"priced"`
+	Price              float64 `db:"price"`
+	Flags              int     `db:"flags"`
+	LastModifiedLedger int     `db:"last_modified_ledger"`
+	Sponsor            string  `db:"sponsor"`
 }
 
 type TrustLine struct {
-	LedgerKey          string `gorm:"column:ledger_key"`
-	AccountID          string `gorm:"column:account_id"`
-	AssetType          int    `gorm:"column:asset_type"`
-	AssetIssuer        string `gorm:"column:asset_issuer"`
-	AssetCode          string `gorm:"column:asset_code"`
-	Balance            int64  `gorm:"column:balance"`
-	TrustLineLimit     int64  `gorm:"column:trust_line_limit"`
-	BuyingLiabilities  int64  `gorm:"column:buying_liabilities"`
-	SellingLiabilities int64  `gorm:"column:selling_liabilities"`
-	Flags              int    `gorm:"column:flags"`
-	LastModifiedLedger int    `gorm:"column:last_modified_ledger"`
+	LedgerKey          string `db:"ledger_key"`
+	AccountID          string `db:"account_id"`
+	AssetType          int    `db:"asset_type"`
+	AssetIssuer        string `db:"asset_issuer"`
+	AssetCode          string `db:"asset_code"`
+	Balance            int64  `db:"balance"`
+	TrustLineLimit     int64  `db:"trust_line_limit"`
+	BuyingLiabilities  int64  `db:"buying_liabilities"`
+	SellingLiabilities int64  `db:"selling_liabilities"`
+	Flags              int    `db:"flags"`
+	LastModifiedLedger int    `db:"last_modified_ledger"`
+	Sponsor            string `db:"sponsor"`
 }
@@ -5,18 +5,37 @@ package graph
 
 import (
 	"context"
-	"encoding/base64"
-	"errors"
-	"fmt"
 	"strconv"
 
+	"github.com/owenjacob/hubblegraphql/apierror"
 	"github.com/owenjacob/hubblegraphql/graph/generated"
 	"github.com/owenjacob/hubblegraphql/graph/model"
 )
 
+func (r *accountResolver) AvailableNativeBalance(ctx context.Context, obj *model.Account) (*string, error) {
+	minimumBalance, ok, err := r.accountMinimumBalance(ctx, obj)
+	if err != nil || !ok {
+		return nil, err
+	}
+	availableBalance := obj.Balance - minimumBalance - obj.SellingLiabilities
+	if availableBalance < 0 {
+		availableBalance = 0
+	}
+	available := formatAmount(availableBalance)
+	return &available, nil
+}
+
+func (r *accountResolver) MinimumBalance(ctx context.Context, obj *model.Account) (*string, error) {
+	minimumBalance, ok, err := r.accountMinimumBalance(ctx, obj)
+	if err != nil || !ok {
+		return nil, err
+	}
+	minimum := formatAmount(minimumBalance)
+	return &minimum, nil
+}
+
 func (r *accountResolver) Balances(ctx context.Context, obj *model.Account) ([]*model.Balance, error) {
-	accountBalances := []TrustLine{}
-	err := r.DB.Table("trust_lines").Where("account_id = ?", obj.ID).Order("balance desc").Find(&accountBalances).Error
+	accountBalances, err := r.Stores.Accounts.TrustLines(ctx, obj.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -24,17 +43,13 @@ func (r *accountResolver) Balances(ctx context.Context, obj *model.Account) ([]*
 	if len(accountBalances) != 0 {
 		balances := make([]*model.Balance, 0, len(accountBalances))
 		for i := range accountBalances {
-			authorized := false
-			if accountBalances[i].Flags == 1 {
-				authorized = true
-			}
 			balances = append(balances, &model.Balance{
-				Balance:            strconv.FormatFloat((float64(accountBalances[i].Balance) / 10000000.0), byte('f'), 7, 64),
-				BuyingLiabilities:  strconv.FormatFloat((float64(accountBalances[i].BuyingLiabilities) / 10000000.0), byte('f'), 7, 64),
-				SellingLiabilities: strconv.FormatFloat((float64(accountBalances[i].SellingLiabilities) / 10000000.0), byte('f'), 7, 64),
-				Limit:              strconv.FormatFloat((float64(accountBalances[i].TrustLineLimit) / 10000000.0), byte('f'), 7, 64),
+				Balance:            formatAmount(accountBalances[i].Balance),
+				BuyingLiabilities:  formatAmount(accountBalances[i].BuyingLiabilities),
+				SellingLiabilities: formatAmount(accountBalances[i].SellingLiabilities),
+				Limit:              formatAmount(accountBalances[i].TrustLineLimit),
 				LastModifiedLedger: accountBalances[i].LastModifiedLedger,
-				IsAuthorized:       authorized,
+				IsAuthorized:       accountBalances[i].Flags&1 != 0,
 				AssetCode:          accountBalances[i].AssetCode,
 				AssetIssuer:        accountBalances[i].AssetIssuer,
 			})
@@ -45,8 +60,7 @@ func (r *accountResolver) Balances(ctx context.Context, obj *model.Account) ([]*
 }
 
 func (r *accountResolver) Signers(ctx context.Context, obj *model.Account) ([]*model.Signer, error) {
-	accountSigners := []AccountSigner{}
-	err := r.DB.Table("accounts_signers").Where("account_id = ?", obj.ID).Order("weight asc").Find(&accountSigners).Error
+	accountSigners, err := r.Stores.Accounts.Signers(ctx, obj.ID)
 	if err != nil {
 		return nil, err
 	}
@@ -61,30 +75,28 @@ func (r *accountResolver) Signers(ctx context.Context, obj *model.Account) ([]*m
 	return signers, nil
 }
 
-func (r *accountResolver) Data(ctx context.Context, obj *model.Account, name *string) ([]*model.Data, error) {
-	accountData := []AccountData{}
-	if name == nil {
-		// If no argument is passed return all data
-		err := r.DB.Table("accounts_data").Where("account_id = ?", obj.ID).Order("name").Find(&accountData).Error
-		if err != nil {
-			return nil, err
-		}
+func (r *accountResolver) Data(ctx context.Context, obj *model.Account, name *string, namePrefix *string) ([]*model.Data, error) {
+	var accountData []AccountData
+	var err error
+	if name != nil && namePrefix != nil {
+		// Cannot match an exact name and a prefix at the same time
+		return nil, apierror.New(apierror.InvalidArgument, "Cannot filter by name and namePrefix")
+	} else if name != nil {
+		accountData, err = r.Stores.Accounts.DataByName(ctx, obj.ID, *name)
+	} else if namePrefix != nil {
+		accountData, err = r.Stores.Accounts.DataByPrefix(ctx, obj.ID, *namePrefix)
 	} else {
-		err := r.DB.Table("accounts_data").Where("account_id =? AND name = ?", obj.ID, name).Order("name").Find(&accountData).Error
-		if err != nil {
-			return nil, err
-		}
+		// If no argument is passed return all data
+		accountData, err = r.Stores.Accounts.DataByPrefix(ctx, obj.ID, "")
+	}
+	if err != nil {
+		return nil, err
 	}
 
 	if len(accountData) != 0 {
 		data := make([]*model.Data, 0, len(accountData))
 		for i := range accountData {
-			b64Val, _ := base64.StdEncoding.DecodeString(accountData[i].Value)
-			value := string(b64Val)
-			data = append(data, &model.Data{
-				Name:  &accountData[i].Name,
-				Value: &value,
-			})
+			data = append(data, parseAccountData(&accountData[i]))
 		}
 		return data, nil
 	}
@@ -92,110 +104,92 @@ func (r *accountResolver) Data(ctx context.Context, obj *model.Account, name *st
 }
 
 func (r *accountResolver) Transactions(ctx context.Context, obj *model.Account, limit *int, order *model.Order, filterBy *model.FilterBy) ([]*model.Transaction, error) {
-	if *limit > maxSearchLimit {
-		return nil, fmt.Errorf("Maximum limit is %d", maxSearchLimit)
+	if err := checkLimit(*limit, r.Limits.MaxSearchLimit); err != nil {
+		return nil, err
 	}
 
-	// Concat order string
-	IDorder := "history_transaction_participants.history_transaction_id " + order.String()
-
-	historyTransactionParticipants := []HistoryTransactionParticipants{}
+	var transactionIDs []int64
 
 	if filterBy != nil {
 		// If filtering options have been defined check them here
 		if filterBy.Account != nil {
 			// Pull DB by accounts
-			return nil, errors.New("pending implementation")
+			return nil, apierror.New(apierror.Unimplemented, "pending implementation")
 		} else if filterBy.Ledger != nil && filterBy.Date != nil {
 			// Cannot filter by ledger and date at the same time
-			return nil, errors.New("Cannot filter by ledger and date")
+			return nil, apierror.New(apierror.InvalidArgument, "Cannot filter by ledger and date")
 		} else if filterBy.Ledger != nil {
 			// Pull DB between ledgers
-			return nil, errors.New("pending implementation")
+			return nil, apierror.New(apierror.Unimplemented, "pending implementation")
 		} else if filterBy.Date != nil {
 			// Pull DB between dates
-			return nil, errors.New("pending implementation")
+			return nil, apierror.New(apierror.Unimplemented, "pending implementation")
 		} else {
 			// Something I didn't think of
-			return nil, errors.New("Unexpected condition")
+			return nil, apierror.New(apierror.Internal, "Unexpected condition")
 		}
 	} else {
 		// If no filtering arguments are specified return transactions according to the limit and order
-		// SELECT history_transaction_participants.history_transaction_id FROM history_accounts INNER JOIN history_transaction_participants ON history_accounts.id = history_transaction_participants.history_account_id WHERE history_accounts.address = 'GBJTZPKVINL5MNHYLJA7FYQ4PECRFS74AHFCKYVBI7NUCFQUERBN2Y7N';
-		err := r.DB.Table("history_accounts").Select("history_transaction_participants.history_transaction_id").Joins("INNER JOIN history_transaction_participants ON history_accounts.id = history_transaction_participants.history_account_id").Where("history_accounts.address = ?", obj.ID).Order(IDorder).Limit(*limit).Find(&historyTransactionParticipants).Error
+		var err error
+		transactionIDs, err = r.Stores.Transactions.AccountTransactionIDs(ctx, obj.ID, *limit, *order)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	if len(historyTransactionParticipants) != 0 {
-		transactions := make([]*model.Transaction, 0, len(historyTransactionParticipants))
+	if len(transactionIDs) != 0 {
+		transactions := make([]*model.Transaction, 0, len(transactionIDs))
 
-		for i := range historyTransactionParticipants {
-			transaction := HistoryTransactions{}
-			err := r.DB.Table("history_transactions").Where("id = ?", historyTransactionParticipants[i].HistoryTransactionID).First(&transaction).Error
+		for i := range transactionIDs {
+			transaction, err := r.Stores.Transactions.TransactionByID(ctx, transactionIDs[i])
 			if err != nil {
 				return nil, err
 			}
-
-			// Parse for pointers
-			transactionCreatedAt := transaction.CreatedAt.Format("2006-01-02 15:04:05")
-			transactionUpdatedAt := transaction.UpdatedAt.Format("2006-01-02 15:04:05")
-			transactionID := strconv.FormatInt(transaction.ID, 10)
-			transactionFeeCharged := strconv.FormatInt(transaction.FeeCharged, 10)
-			transactionNewMaxFee := strconv.FormatInt(transaction.NewMaxFee, 10)
-			transactionTimebounds := make([]*int, 0, len(transaction.TimeBounds))
-			for j := range transaction.TimeBounds {
-				timebound := int(transaction.TimeBounds[j])
-				transactionTimebounds = append(transactionTimebounds, &timebound)
-			}
-			transactionInnerSignatures := make([]*string, 0, len(transaction.InnerSignatures))
-			for j := range transaction.InnerSignatures {
-				transactionInnerSignatures = append(transactionInnerSignatures, &transaction.InnerSignatures[j])
+			if transaction == nil {
+				// Participants are written before the transaction itself, or a replica lags
+				continue
 			}
 
-			transactions = append(transactions, &model.Transaction{
-				TransactionHash:      transaction.TransactionHash,
-				LedgerSequence:       transaction.LedgerSequence,
-				ApplicationOrder:     transaction.ApplicationOrder,
-				Account:              transaction.Account,
-				AccountSequence:      strconv.FormatInt(transaction.AccountSequence, 10),
-				MaxFee:               strconv.FormatFloat((float64(transaction.MaxFee) / 10000000.0), byte('f'), 7, 64),
-				OperationCount:       transaction.OperationCount,
-				CreatedAt:            &transactionCreatedAt,
-				UpdatedAt:            &transactionUpdatedAt,
-				ID:                   &transactionID,
-				TxEnvelope:           transaction.TxEnvelope,
-				TxResult:             transaction.TxResult,
-				TxMeta:               transaction.TxMeta,
-				TxFeeMeta:            transaction.TxFeeMeta,
-				Signatures:           transaction.Signatures,
-				MemoType:             transaction.MemoType,
-				Memo:                 &transaction.Memo,
-				TimeBounds:           transactionTimebounds,
-				Successful:           &transaction.Successful,
-				FeeCharged:           &transactionFeeCharged,
-				InnerTransactionHash: &transaction.InnerTransactionHash,
-				FeeAccount:           &transaction.FeeAccount,
-				InnerSignatures:      transactionInnerSignatures,
-				NewMaxFee:            &transactionNewMaxFee,
-			})
+			transactions = append(transactions, parseTransaction(transaction))
 		}
 		return transactions, nil
 	}
 	return nil, nil
 }
 
-func (r *ledgerResolver) Transactions(ctx context.Context, obj *model.Ledger, limit *int, order *model.Order) ([]*model.Transaction, error) {
-	if *limit > maxTxnsLimit {
-		return nil, fmt.Errorf("ledgers cannot contain more than %d transactions", maxTxnsLimit)
+func (r *accountResolver) Sponsoring(ctx context.Context, obj *model.Account, limit *int) ([]*model.SponsoredEntry, error) {
+	if err := checkLimit(*limit, r.Limits.MaxSearchLimit); err != nil {
+		return nil, err
+	}
+
+	sponsored, err := r.Stores.Accounts.Sponsoring(ctx, obj.ID, *limit)
+	if err != nil {
+		return nil, err
+	}
+	return parseSponsoredEntries(sponsored, *limit), nil
+}
+
+func (r *accountResolver) SponsoredBy(ctx context.Context, obj *model.Account, limit *int) ([]*model.SponsoredEntry, error) {
+	if err := checkLimit(*limit, r.Limits.MaxSearchLimit); err != nil {
+		return nil, err
+	}
+
+	sponsored, err := r.Stores.Accounts.SponsoredBy(ctx, obj.ID, *limit)
+	if err != nil {
+		return nil, err
 	}
+	return parseSponsoredEntries(sponsored, *limit), nil
+}
 
-	// Concat order string
-	IDorder := "id " + order.String()
+func (r *ledgerResolver) Transactions(ctx context.Context, obj *model.Ledger, limit *int, order *model.Order) ([]*model.Transaction, error) {
+	if *limit < 0 {
+		return nil, errNegativeLimit
+	}
+	if *limit > r.Limits.MaxTxnsLimit {
+		return nil, apierror.Errorf(apierror.LimitExceeded, "ledgers cannot contain more than %d transactions", r.Limits.MaxTxnsLimit)
+	}
 
-	ledgerTransactions := []HistoryTransactions{}
-	err := r.DB.Table("history_transactions").Where("ledger_sequence = ?", obj.Sequence).Order(IDorder).Limit(*limit).Find(&ledgerTransactions).Error
+	ledgerTransactions, err := r.Stores.Transactions.LedgerTransactions(ctx, obj.Sequence, *limit, *order)
 	if err != nil {
 		return nil, err
 	}
@@ -204,48 +198,7 @@ func (r *ledgerResolver) Transactions(ctx context.Context, obj *model.Ledger, li
 		transactions := make([]*model.Transaction, 0, len(ledgerTransactions))
 
 		for i := range ledgerTransactions {
-			// Parse for pointers
-			transactionCreatedAt := ledgerTransactions[i].CreatedAt.Format("2006-01-02 15:04:05")
-			transactionUpdatedAt := ledgerTransactions[i].UpdatedAt.Format("2006-01-02 15:04:05")
-			transactionID := strconv.FormatInt(ledgerTransactions[i].ID, 10)
-			transactionFeeCharged := strconv.FormatInt(ledgerTransactions[i].FeeCharged, 10)
-			transactionNewMaxFee := strconv.FormatInt(ledgerTransactions[i].NewMaxFee, 10)
-			transactionTimebounds := make([]*int, 0, len(ledgerTransactions[i].TimeBounds))
-			for j := range ledgerTransactions[i].TimeBounds {
-				timebound := int(ledgerTransactions[i].TimeBounds[j])
-				transactionTimebounds = append(transactionTimebounds, &timebound)
-			}
-			transactionInnerSignatures := make([]*string, 0, len(ledgerTransactions[i].InnerSignatures))
-			for j := range ledgerTransactions[i].InnerSignatures {
-				transactionInnerSignatures = append(transactionInnerSignatures, &ledgerTransactions[i].InnerSignatures[j])
-			}
-
-			transactions = append(transactions, &model.Transaction{
-				TransactionHash:      ledgerTransactions[i].TransactionHash,
-				LedgerSequence:       ledgerTransactions[i].LedgerSequence,
-				ApplicationOrder:     ledgerTransactions[i].ApplicationOrder,
-				Account:              ledgerTransactions[i].Account,
-				AccountSequence:      strconv.FormatInt(ledgerTransactions[i].AccountSequence, 10),
-				MaxFee:               strconv.FormatFloat((float64(ledgerTransactions[i].MaxFee) / 10000000.0), byte('f'), 7, 64),
-				OperationCount:       ledgerTransactions[i].OperationCount,
-				CreatedAt:            &transactionCreatedAt,
-				UpdatedAt:            &transactionUpdatedAt,
-				ID:                   &transactionID,
-				TxEnvelope:           ledgerTransactions[i].TxEnvelope,
-				TxResult:             ledgerTransactions[i].TxResult,
-				TxMeta:               ledgerTransactions[i].TxMeta,
-				TxFeeMeta:            ledgerTransactions[i].TxFeeMeta,
-				Signatures:           ledgerTransactions[i].Signatures,
-				MemoType:             ledgerTransactions[i].MemoType,
-				Memo:                 &ledgerTransactions[i].Memo,
-				TimeBounds:           transactionTimebounds,
-				Successful:           &ledgerTransactions[i].Successful,
-				FeeCharged:           &transactionFeeCharged,
-				InnerTransactionHash: &ledgerTransactions[i].InnerTransactionHash,
-				FeeAccount:           &ledgerTransactions[i].FeeAccount,
-				InnerSignatures:      transactionInnerSignatures,
-				NewMaxFee:            &transactionNewMaxFee,
-			})
+			transactions = append(transactions, parseTransaction(&ledgerTransactions[i]))
 		}
 		return transactions, nil
 	}
@@ -253,95 +206,88 @@ func (r *ledgerResolver) Transactions(ctx context.Context, obj *model.Ledger, li
 }
 
 func (r *queryResolver) Account(ctx context.Context, pubKey string) (*model.Account, error) {
-	account := Account{}
-	notFound := r.DB.Table("accounts").Where("account_id = ?", pubKey).First(&account).RecordNotFound()
-	if notFound {
-		return nil, errors.New("Account not found")
+	account, err := r.Stores.Accounts.Account(ctx, pubKey)
+	if err != nil || account == nil {
+		return nil, err
+	}
+
+	var sponsor *string
+	if account.Sponsor != "" {
+		sponsor = &account.Sponsor
+	}
+	var inflationDest *string
+	if account.InflationDest != "" {
+		inflationDest = &account.InflationDest
 	}
 
 	return &model.Account{
-		ID:              account.AccountID,
-		Sequence:        strconv.FormatInt(account.SeqNum, 10),
-		HomeDomain:      account.HomeDomain,
-		NativeBalance:   strconv.FormatFloat((float64(account.Balance) / 10000000.0), byte('f'), 7, 64),
-		MasterWeight:    account.MasterWeight,
-		LowThreshold:    account.Low,
-		MediumThreshold: account.Medium,
-		HighThreshold:   account.High,
-		Flags:           parseAccountFlags(account.Flags),
+		ID:                   account.AccountID,
+		Sequence:             strconv.FormatInt(account.SeqNum, 10),
+		HomeDomain:           account.HomeDomain,
+		NativeBalance:        formatAmount(account.Balance),
+		InflationDestination: inflationDest,
+		SubentryCount:        account.Subentries,
+		LastModifiedLedger:   account.LastModified,
+		MasterWeight:         account.MasterWeight,
+		LowThreshold:         account.Low,
+		MediumThreshold:      account.Medium,
+		HighThreshold:        account.High,
+		Flags:                parseAccountFlags(account.Flags),
+		Sponsor:              sponsor,
+		NumSponsoring:        account.NumSponsoring,
+		NumSponsored:         account.NumSponsored,
+		Balance:              account.Balance,
+		SellingLiabilities:   account.SellingLiabilities,
 	}, nil
 }
 
 func (r *queryResolver) Transaction(ctx context.Context, hash string) (*model.Transaction, error) {
-	transaction := HistoryTransactions{}
-	notFound := r.DB.Table("history_transactions").Where("transaction_hash = ?", hash).First(&transaction).RecordNotFound()
-	if notFound {
-		return nil, errors.New("Transaction not found")
-	}
-
-	// Parse for pointers
-	transactionCreatedAt := transaction.CreatedAt.Format("2006-01-02 15:04:05")
-	transactionUpdatedAt := transaction.UpdatedAt.Format("2006-01-02 15:04:05")
-	transactionID := strconv.FormatInt(transaction.ID, 10)
-	transactionFeeCharged := strconv.FormatInt(transaction.FeeCharged, 10)
-	transactionNewMaxFee := strconv.FormatInt(transaction.NewMaxFee, 10)
-	transactionTimebounds := make([]*int, 0, len(transaction.TimeBounds))
-	for i := range transaction.TimeBounds {
-		timebound := int(transaction.TimeBounds[i])
-		transactionTimebounds = append(transactionTimebounds, &timebound)
-	}
-	transactionInnerSignatures := make([]*string, 0, len(transaction.InnerSignatures))
-	for i := range transaction.InnerSignatures {
-		transactionInnerSignatures = append(transactionInnerSignatures, &transaction.InnerSignatures[i])
-	}
-
-	return &model.Transaction{
-		TransactionHash:      transaction.TransactionHash,
-		LedgerSequence:       transaction.LedgerSequence,
-		ApplicationOrder:     transaction.ApplicationOrder,
-		Account:              transaction.Account,
-		AccountSequence:      strconv.FormatInt(transaction.AccountSequence, 10),
-		MaxFee:               strconv.FormatFloat((float64(transaction.MaxFee) / 10000000.0), byte('f'), 7, 64),
-		OperationCount:       transaction.OperationCount,
-		CreatedAt:            &transactionCreatedAt,
-		UpdatedAt:            &transactionUpdatedAt,
-		ID:                   &transactionID,
-		TxEnvelope:           transaction.TxEnvelope,
-		TxResult:             transaction.TxResult,
-		TxMeta:               transaction.TxMeta,
-		TxFeeMeta:            transaction.TxFeeMeta,
-		Signatures:           transaction.Signatures,
-		MemoType:             transaction.MemoType,
-		Memo:                 &transaction.Memo,
-		TimeBounds:           transactionTimebounds,
-		Successful:           &transaction.Successful,
-		FeeCharged:           &transactionFeeCharged,
-		InnerTransactionHash: &transaction.InnerTransactionHash,
-		FeeAccount:           &transaction.FeeAccount,
-		InnerSignatures:      transactionInnerSignatures,
-		NewMaxFee:            &transactionNewMaxFee,
-	}, nil
+	transaction, err := r.Stores.Transactions.TransactionByHash(ctx, hash)
+	if err != nil || transaction == nil {
+		return nil, err
+	}
+
+	parsed := parseTransaction(transaction)
+	if hash != transaction.TransactionHash {
+		// Looked up by the inner transaction's hash
+		return parsed.InnerTransaction, nil
+	}
+	return parsed, nil
 }
 
-func (r *queryResolver) Ledger(ctx context.Context, number *int, limit *int, order *model.Order, filterBy *model.FilterBy) ([]*model.Ledger, error) {
-	if *limit > maxSearchLimit {
-		return nil, fmt.Errorf("Maximum limit is %d", maxSearchLimit)
+func (r *queryResolver) Operation(ctx context.Context, id string) (*model.Operation, error) {
+	operationID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, apierror.Errorf(apierror.InvalidArgument, "Invalid operation id %q", id)
 	}
 
-	// Concat order string
-	IDorder := "id " + order.String()
+	operation, err := r.Stores.Operations.OperationByID(ctx, operationID)
+	if err != nil || operation == nil {
+		return nil, err
+	}
+	return parseOperation(operation), nil
+}
+
+func (r *queryResolver) Ledger(ctx context.Context, number *int, limit *int, order *model.Order, filterBy *model.FilterBy) ([]*model.Ledger, error) {
+	if err := checkLimit(*limit, r.Limits.MaxSearchLimit); err != nil {
+		return nil, err
+	}
 
 	ledger := []HistoryLedgers{}
 
 	if number != nil {
 		// If a specific ledger is requested ignore everything else
-		err := r.DB.Table("history_ledgers").Where("sequence = ?", number).First(&ledger).Error
+		found, err := r.Stores.Ledgers.LedgerBySequence(ctx, *number)
 		if err != nil {
-			return nil, errors.New("Ledger not found")
+			return nil, err
+		}
+		if found != nil {
+			ledger = append(ledger, *found)
 		}
 	} else if limit != nil && filterBy == nil {
 		// If a specific limit is set and there are no filtering options pull the latest ledgers
-		err := r.DB.Table("history_ledgers").Order(IDorder).Limit(*limit).Find(&ledger).Error
+		var err error
+		ledger, err = r.Stores.Ledgers.Ledgers(ctx, *limit, *order)
 		if err != nil {
 			return nil, err
 		}
@@ -349,90 +295,222 @@ func (r *queryResolver) Ledger(ctx context.Context, number *int, limit *int, ord
 		// If filtering options have been defined check them here
 		if filterBy.Account != nil {
 			// Cannot filter by accounts (maybe via super complex query later?)
-			return nil, errors.New("Cannot filter ledgers by accounts")
+			return nil, apierror.New(apierror.InvalidArgument, "Cannot filter ledgers by accounts")
 		} else if filterBy.Ledger != nil && filterBy.Date != nil {
 			// Cannot filter by ledger and date at the same time
-			return nil, errors.New("Cannot filter by ledger and date")
+			return nil, apierror.New(apierror.InvalidArgument, "Cannot filter by ledger and date")
 		} else if filterBy.Ledger != nil {
 			// Pull DB between ledgers
-			return nil, errors.New("pending implementation")
+			return nil, apierror.New(apierror.Unimplemented, "pending implementation")
 		} else if filterBy.Date != nil {
 			// Pull DB between dates
-			return nil, errors.New("pending implementation")
+			return nil, apierror.New(apierror.Unimplemented, "pending implementation")
 		} else {
 			// Something I didn't think of
-			return nil, errors.New("Unexpected condition")
+			return nil, apierror.New(apierror.Internal, "Unexpected condition")
 		}
 	} else {
 		// If no arguments are specified just return the latest ledger
-		err := r.DB.Table("history_ledgers").Last(&ledger).Error
+		latest, err := r.Stores.Ledgers.LatestLedger(ctx)
 		if err != nil {
 			return nil, err
 		}
+		if latest != nil {
+			ledger = append(ledger, *latest)
+		}
 	}
 
 	if len(ledger) != 0 {
 		ledgers := make([]*model.Ledger, 0, len(ledger))
 
 		for i := range ledger {
-			ledgerCreatedAt := ledger[i].CreatedAt.Format("2006-01-02 15:04:05")
-			ledgerUpdatedAt := ledger[i].UpdatedAt.Format("2006-01-02 15:04:05")
-			ledgerID := int(ledger[i].ID)
-
-			ledgers = append(ledgers, &model.Ledger{
-				Sequence:                   ledger[i].Sequence,
-				LedgerHash:                 ledger[i].LedgerHash,
-				PreviousLedgerHash:         &ledger[i].PreviousLedgerHash,
-				TransactionCount:           ledger[i].TransactionCount,
-				OperationCount:             ledger[i].OperationCount,
-				ClosedAt:                   ledger[i].ClosedAt.Format("2006-01-02 15:04:05"),
-				CreatedAt:                  &ledgerCreatedAt,
-				UpdatedAt:                  &ledgerUpdatedAt,
-				ID:                         &ledgerID,
-				ImporterVersion:            ledger[i].ImporterVersion,
-				TotalCoins:                 strconv.FormatFloat((float64(ledger[i].TotalCoins) / 10000000.0), byte('f'), 7, 64),
-				FeePool:                    strconv.FormatFloat((float64(ledger[i].FeePool) / 10000000.0), byte('f'), 7, 64),
-				BaseFee:                    ledger[i].BaseFee,
-				BaseReserve:                ledger[i].BaseReserve,
-				MaxTxSetSize:               ledger[i].MaxTxSetSize,
-				ProtocolVersion:            ledger[i].ProtocolVersion,
-				LedgerHeader:               &ledger[i].LedgerHeader,
-				SuccessfulTransactionCount: &ledger[i].SuccessfulTransactionCount,
-				FailedTransactionCount:     &ledger[i].FailedTransactionCount,
-			})
+			ledgers = append(ledgers, parseLedger(&ledger[i]))
 		}
 		return ledgers, nil
 	}
-	return nil, errors.New("Query failed")
+	// No matching ledgers
+	return nil, nil
+}
+
+func (r *queryResolver) StrictSendPaths(ctx context.Context, sourceAsset string, sourceAmount string, destinationAssets []string, destinationAccount *string, maxHops *int, limit *int) ([]*model.Path, error) {
+	if err := checkLimit(*limit, r.Limits.MaxSearchLimit); err != nil {
+		return nil, err
+	}
+	if *maxHops < 1 {
+		return nil, apierror.Errorf(apierror.InvalidArgument, "maxHops must be between 1 and %d", r.Limits.MaxPathHops)
+	}
+	if *maxHops > r.Limits.MaxPathHops {
+		return nil, apierror.Errorf(apierror.LimitExceeded, "maxHops must be between 1 and %d", r.Limits.MaxPathHops)
+	}
+
+	source, err := parseAsset(sourceAsset)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(sourceAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	destinations := []asset{}
+	if destinationAssets != nil && destinationAccount != nil {
+		return nil, apierror.New(apierror.InvalidArgument, "Cannot search by destinationAssets and destinationAccount")
+	} else if destinationAssets != nil {
+		destinations, err = parseAssets(destinationAssets)
+		if err != nil {
+			return nil, err
+		}
+	} else if destinationAccount != nil {
+		destinations, _, err = r.accountAssets(ctx, *destinationAccount)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, apierror.New(apierror.InvalidArgument, "Either destinationAssets or destinationAccount is required")
+	}
+
+	paths, err := newPathFinder(ctx, r.Stores.OrderBook, r.Limits.MaxOrderBookOffers, r.Limits.MaxPathExpansions).strictSend(source, amount, destinations, *maxHops)
+	if err != nil {
+		return nil, err
+	}
+	return parsePaths(paths, *limit), nil
+}
+
+func (r *queryResolver) StrictReceivePaths(ctx context.Context, sourceAssets []string, sourceAccount *string, destinationAsset string, destinationAmount string, maxHops *int, limit *int) ([]*model.Path, error) {
+	if err := checkLimit(*limit, r.Limits.MaxSearchLimit); err != nil {
+		return nil, err
+	}
+	if *maxHops < 1 {
+		return nil, apierror.Errorf(apierror.InvalidArgument, "maxHops must be between 1 and %d", r.Limits.MaxPathHops)
+	}
+	if *maxHops > r.Limits.MaxPathHops {
+		return nil, apierror.Errorf(apierror.LimitExceeded, "maxHops must be between 1 and %d", r.Limits.MaxPathHops)
+	}
+
+	destination, err := parseAsset(destinationAsset)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := parseAmount(destinationAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []asset{}
+	var balances map[string]int64
+	if sourceAssets != nil && sourceAccount != nil {
+		return nil, apierror.New(apierror.InvalidArgument, "Cannot search by sourceAssets and sourceAccount")
+	} else if sourceAssets != nil {
+		sources, err = parseAssets(sourceAssets)
+		if err != nil {
+			return nil, err
+		}
+	} else if sourceAccount != nil {
+		sources, balances, err = r.accountAssets(ctx, *sourceAccount)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		return nil, apierror.New(apierror.InvalidArgument, "Either sourceAssets or sourceAccount is required")
+	}
+
+	paths, err := newPathFinder(ctx, r.Stores.OrderBook, r.Limits.MaxOrderBookOffers, r.Limits.MaxPathExpansions).strictReceive(sources, destination, amount, *maxHops)
+	if err != nil {
+		return nil, err
+	}
+
+	if balances != nil {
+		// Only keep the paths the source account can afford
+		affordable := make([]paymentPath, 0, len(paths))
+		for i := range paths {
+			if paths[i].SourceAmount <= balances[paths[i].Source.String()] {
+				affordable = append(affordable, paths[i])
+			}
+		}
+		paths = affordable
+	}
+	return parsePaths(paths, *limit), nil
+}
+
+func (r *queryResolver) FeeStats(ctx context.Context, ledgers *int) (*model.FeeStats, error) {
+	if *ledgers < 1 {
+		return nil, apierror.Errorf(apierror.InvalidArgument, "ledgers must be between 1 and %d", r.Limits.MaxFeeStatsLedgers)
+	}
+	if *ledgers > r.Limits.MaxFeeStatsLedgers {
+		return nil, apierror.Errorf(apierror.LimitExceeded, "ledgers must be between 1 and %d", r.Limits.MaxFeeStatsLedgers)
+	}
+
+	recentLedgers, err := r.Stores.Ledgers.RecentLedgerCapacity(ctx, *ledgers)
+	if err != nil {
+		return nil, err
+	}
+	if len(recentLedgers) == 0 {
+		return nil, nil
+	}
+
+	operationCount, maxTxSetSize := 0, 0
+	for i := range recentLedgers {
+		operationCount += recentLedgers[i].OperationCount
+		maxTxSetSize += recentLedgers[i].MaxTxSetSize
+	}
+	capacityUsage := 0.0
+	if maxTxSetSize > 0 {
+		capacityUsage = float64(operationCount) / float64(maxTxSetSize)
+	}
+
+	lastLedger := recentLedgers[0]
+	firstLedger := recentLedgers[len(recentLedgers)-1]
+	transactionFees, err := r.Stores.Transactions.TransactionFees(ctx, firstLedger.Sequence, lastLedger.Sequence)
+	if err != nil {
+		return nil, err
+	}
+	charged, bids := perOperationFees(transactionFees)
+
+	return &model.FeeStats{
+		LastLedger:          lastLedger.Sequence,
+		LastLedgerBaseFee:   lastLedger.BaseFee,
+		LedgerCount:         len(recentLedgers),
+		LedgerCapacityUsage: capacityUsage,
+		OperationCount:      operationCount,
+		MaxTxSetSize:        maxTxSetSize,
+		FeeCharged:          feeDistribution(charged, lastLedger.BaseFee),
+		MaxFee:              feeDistribution(bids, lastLedger.BaseFee),
+	}, nil
+}
+
+func (r *transactionResolver) Preconditions(ctx context.Context, obj *model.Transaction) (*model.Preconditions, error) {
+	return parsePreconditions(obj)
 }
 
 func (r *transactionResolver) Operations(ctx context.Context, obj *model.Transaction, limit *int, order *model.Order) ([]*model.Operation, error) {
 	// Check max limit
-	if *limit > maxOpsLimit {
-		return nil, fmt.Errorf("transactions cannot contain more than %d operations", maxOpsLimit)
+	if *limit < 0 {
+		return nil, errNegativeLimit
+	}
+	if *limit > r.Limits.MaxOpsLimit {
+		return nil, apierror.Errorf(apierror.LimitExceeded, "transactions cannot contain more than %d operations", r.Limits.MaxOpsLimit)
 	}
 
-	// Concat order string
-	IDorder := "id " + order.String()
-
-	transactionOperations := []HistoryOperations{}
-	err := r.DB.Table("history_operations").Where("transaction_id = ?", obj.ID).Order(IDorder).Limit(*limit).Find(&transactionOperations).Error
+	transactionID, err := strconv.ParseInt(*obj.ID, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	transactionOperations, err := r.Stores.Operations.TransactionOperations(ctx, transactionID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Ordered and limited here so that every query shares the cached operations
 	operations := make([]*model.Operation, 0, len(transactionOperations))
 	for i := range transactionOperations {
-		detail, _ := transactionOperations[i].Details.MarshalJSON()
-		details := string(detail)
-		operations = append(operations, &model.Operation{
-			ID:               strconv.FormatInt(transactionOperations[i].ID, 10),
-			TransactionID:    strconv.FormatInt(transactionOperations[i].TransactionID, 10),
-			ApplicationOrder: transactionOperations[i].ApplicationOrder,
-			Type:             transactionOperations[i].Type,
-			Details:          &details,
-			SourceAccount:    transactionOperations[i].SourceAccount,
-		})
+		if len(operations) == *limit {
+			break
+		}
+		operation := &transactionOperations[i]
+		if *order == model.OrderDesc {
+			operation = &transactionOperations[len(transactionOperations)-1-i]
+		}
+		operations = append(operations, parseOperation(operation))
 	}
 	return operations, nil
 }
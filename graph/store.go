@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"context"
+
+	"github.com/owenjacob/hubblegraphql/graph/model"
+)
+
+// Resolvers only read through these stores. PostgresStore is backed by Horizon's database and
+// MemoryStore holds fixed rows for tests. Lookups of a single row return nil without an error
+// when there is no such row.
+type Stores struct {
+	Ledgers      LedgerStore
+	Transactions TransactionStore
+	Operations   OperationStore
+	Accounts     AccountStore
+	OrderBook    OrderBookStore
+}
+
+type LedgerStore interface {
+	LedgerBySequence(ctx context.Context, sequence int) (*HistoryLedgers, error)
+	// Ordered by id
+	Ledgers(ctx context.Context, limit int, order model.Order) ([]HistoryLedgers, error)
+	LatestLedger(ctx context.Context) (*HistoryLedgers, error)
+	// The most recent count ledgers, newest first
+	RecentLedgerCapacity(ctx context.Context, count int) ([]LedgerCapacity, error)
+}
+
+type TransactionStore interface {
+	// Matches a fee bump by its inner transaction's hash too
+	TransactionByHash(ctx context.Context, hash string) (*HistoryTransactions, error)
+	TransactionByID(ctx context.Context, id int64) (*HistoryTransactions, error)
+	// Ordered by id
+	LedgerTransactions(ctx context.Context, sequence int, limit int, order model.Order) ([]HistoryTransactions, error)
+	// Ids of the transactions the account took part in, ordered by id
+	AccountTransactionIDs(ctx context.Context, accountID string, limit int, order model.Order) ([]int64, error)
+	// Fees of every transaction in the ledgers from first to last inclusive
+	TransactionFees(ctx context.Context, firstLedger int, lastLedger int) ([]TransactionFee, error)
+}
+
+type OperationStore interface {
+	OperationByID(ctx context.Context, id int64) (*HistoryOperations, error)
+	// Every operation of the transaction in application order
+	TransactionOperations(ctx context.Context, transactionID int64) ([]HistoryOperations, error)
+}
+
+type AccountStore interface {
+	Account(ctx context.Context, accountID string) (*Account, error)
+	// Largest balance first
+	TrustLines(ctx context.Context, accountID string) ([]TrustLine, error)
+	// Lowest weight first
+	Signers(ctx context.Context, accountID string) ([]AccountSigner, error)
+	DataByName(ctx context.Context, accountID string, name string) ([]AccountData, error)
+	// Ordered by name, an empty prefix matches every entry
+	DataByPrefix(ctx context.Context, accountID string, prefix string) ([]AccountData, error)
+	// Entries whose reserve is paid by sponsor, at most limit of each type
+	Sponsoring(ctx context.Context, sponsor string, limit int) (*SponsoredEntries, error)
+	// Entries of the account whose reserve someone else pays, at most limit of each type
+	SponsoredBy(ctx context.Context, accountID string, limit int) (*SponsoredEntries, error)
+}
+
+type OrderBookStore interface {
+	// Live offers selling or buying the asset, given as base64 XDR, grouped by the asset on
+	// the other side and cheapest first within each group, at most limit per group
+	OffersSelling(ctx context.Context, asset string, limit int) ([]Offer, error)
+	OffersBuying(ctx context.Context, asset string, limit int) ([]Offer, error)
+	// Live pools holding the asset, given as base64 XDR
+	LiquidityPools(ctx context.Context, asset string) ([]LiquidityPool, error)
+}
+
+// Sponsored ledger entries by type. Claimable balances have no owning account, so only
+// Sponsoring returns them.
+type SponsoredEntries struct {
+	Accounts          []Account
+	TrustLines        []TrustLine
+	Data              []AccountData
+	Signers           []AccountSigner
+	ClaimableBalances []ClaimableBalance
+}
@@ -0,0 +1,139 @@
+package graph
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/owenjacob/hubblegraphql/entitycache"
+	"github.com/owenjacob/hubblegraphql/graph/model"
+)
+
+// Kinds of entity in the entity cache
+const (
+	kindLedger                = "ledger"
+	kindTransactionHash       = "transaction_hash"
+	kindTransactionID         = "transaction_id"
+	kindOperation             = "operation"
+	kindTransactionOperations = "transaction_operations"
+)
+
+// WithCache looks history rows up in cache before asking the wrapped stores, and keeps the
+// rows they return. Accounts and the order book change with every ledger and are never
+// cached. A nil cache returns stores unchanged.
+func WithCache(stores Stores, cache *entitycache.Cache) Stores {
+	if cache == nil {
+		return stores
+	}
+	stores.Ledgers = cachedLedgers{stores.Ledgers, cache}
+	stores.Transactions = cachedTransactions{stores.Transactions, cache}
+	stores.Operations = cachedOperations{stores.Operations, cache}
+	return stores
+}
+
+type cachedLedgers struct {
+	LedgerStore
+	cache *entitycache.Cache
+}
+
+func (c cachedLedgers) LedgerBySequence(ctx context.Context, sequence int) (*HistoryLedgers, error) {
+	if cached, ok := c.cache.Get(kindLedger, strconv.Itoa(sequence)); ok {
+		ledger := cached.(HistoryLedgers)
+		return &ledger, nil
+	}
+	ledger, err := c.LedgerStore.LedgerBySequence(ctx, sequence)
+	if ledger != nil {
+		c.add(*ledger)
+	}
+	return ledger, err
+}
+
+func (c cachedLedgers) Ledgers(ctx context.Context, limit int, order model.Order) ([]HistoryLedgers, error) {
+	ledgers, err := c.LedgerStore.Ledgers(ctx, limit, order)
+	for i := range ledgers {
+		c.add(ledgers[i])
+	}
+	return ledgers, err
+}
+
+func (c cachedLedgers) add(ledger HistoryLedgers) {
+	c.cache.Add(kindLedger, strconv.Itoa(ledger.Sequence), ledger)
+}
+
+type cachedTransactions struct {
+	TransactionStore
+	cache *entitycache.Cache
+}
+
+func (c cachedTransactions) TransactionByHash(ctx context.Context, hash string) (*HistoryTransactions, error) {
+	if cached, ok := c.cache.Get(kindTransactionHash, hash); ok {
+		transaction := cached.(HistoryTransactions)
+		return &transaction, nil
+	}
+	transaction, err := c.TransactionStore.TransactionByHash(ctx, hash)
+	if transaction != nil {
+		c.add(*transaction)
+	}
+	return transaction, err
+}
+
+func (c cachedTransactions) TransactionByID(ctx context.Context, id int64) (*HistoryTransactions, error) {
+	if cached, ok := c.cache.Get(kindTransactionID, strconv.FormatInt(id, 10)); ok {
+		transaction := cached.(HistoryTransactions)
+		return &transaction, nil
+	}
+	transaction, err := c.TransactionStore.TransactionByID(ctx, id)
+	if transaction != nil {
+		c.add(*transaction)
+	}
+	return transaction, err
+}
+
+func (c cachedTransactions) LedgerTransactions(ctx context.Context, sequence int, limit int, order model.Order) ([]HistoryTransactions, error) {
+	transactions, err := c.TransactionStore.LedgerTransactions(ctx, sequence, limit, order)
+	for i := range transactions {
+		c.add(transactions[i])
+	}
+	return transactions, err
+}
+
+// Cached under both keys, each of which counts against the memory cap
+func (c cachedTransactions) add(transaction HistoryTransactions) {
+	c.cache.Add(kindTransactionHash, transaction.TransactionHash, transaction)
+	if transaction.InnerTransactionHash != "" {
+		c.cache.Add(kindTransactionHash, transaction.InnerTransactionHash, transaction)
+	}
+	c.cache.Add(kindTransactionID, strconv.FormatInt(transaction.ID, 10), transaction)
+}
+
+type cachedOperations struct {
+	OperationStore
+	cache *entitycache.Cache
+}
+
+func (c cachedOperations) OperationByID(ctx context.Context, id int64) (*HistoryOperations, error) {
+	key := strconv.FormatInt(id, 10)
+	if cached, ok := c.cache.Get(kindOperation, key); ok {
+		operation := cached.(HistoryOperations)
+		return &operation, nil
+	}
+	operation, err := c.OperationStore.OperationByID(ctx, id)
+	if operation != nil {
+		c.cache.Add(kindOperation, key, *operation)
+	}
+	return operation, err
+}
+
+// The network caps how many operations a transaction can hold, so they are always loaded and
+// cached together
+func (c cachedOperations) TransactionOperations(ctx context.Context, transactionID int64) ([]HistoryOperations, error) {
+	key := strconv.FormatInt(transactionID, 10)
+	if cached, ok := c.cache.Get(kindTransactionOperations, key); ok {
+		return cached.([]HistoryOperations), nil
+	}
+	operations, err := c.OperationStore.TransactionOperations(ctx, transactionID)
+	// A transaction that hasn't been ingested yet looks the same as one without operations
+	if len(operations) != 0 {
+		c.cache.Add(kindTransactionOperations, key, operations)
+	}
+	return operations, err
+}
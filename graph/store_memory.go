@@ -0,0 +1,260 @@
+package graph
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/owenjacob/hubblegraphql/graph/model"
+)
+
+// MemoryStore answers from the rows it holds, ordering and limiting them the way Postgres
+// would. It is meant for tests, so rows are never copied and must not change while in use.
+type MemoryStore struct {
+	HistoryLedgers      []HistoryLedgers
+	HistoryTransactions []HistoryTransactions
+	HistoryOperations   []HistoryOperations
+	// Ids of the transactions each account took part in
+	Participants      map[string][]int64
+	Accounts          []Account
+	AccountTrustLines []TrustLine
+	AccountSigners    []AccountSigner
+	AccountData       []AccountData
+	ClaimableBalances []ClaimableBalance
+	Offers            []Offer
+	Pools             []LiquidityPool
+}
+
+// NewMemoryStores uses m for every store
+func NewMemoryStores(m *MemoryStore) Stores {
+	return Stores{Ledgers: m, Transactions: m, Operations: m, Accounts: m, OrderBook: m}
+}
+
+// Rows for which keep is true, in their original order
+func filterRows[T any](rows []T, keep func(*T) bool) []T {
+	kept := []T{}
+	for i := range rows {
+		if keep(&rows[i]) {
+			kept = append(kept, rows[i])
+		}
+	}
+	return kept
+}
+
+// Sorts rows by the int64 key in the given order and keeps at most limit of them
+func orderRows[T any](rows []T, key func(*T) int64, order model.Order, limit int) []T {
+	sort.SliceStable(rows, func(i, j int) bool {
+		if order == model.OrderDesc {
+			return key(&rows[i]) > key(&rows[j])
+		}
+		return key(&rows[i]) < key(&rows[j])
+	})
+	return truncate(rows, limit)
+}
+
+func firstRow[T any](rows []T, match func(*T) bool) *T {
+	for i := range rows {
+		if match(&rows[i]) {
+			return &rows[i]
+		}
+	}
+	return nil
+}
+
+func (m *MemoryStore) LedgerBySequence(ctx context.Context, sequence int) (*HistoryLedgers, error) {
+	return firstRow(m.HistoryLedgers, func(l *HistoryLedgers) bool { return l.Sequence == sequence }), nil
+}
+
+func (m *MemoryStore) Ledgers(ctx context.Context, limit int, order model.Order) ([]HistoryLedgers, error) {
+	ledgers := append([]HistoryLedgers{}, m.HistoryLedgers...)
+	return orderRows(ledgers, func(l *HistoryLedgers) int64 { return l.ID }, order, limit), nil
+}
+
+func (m *MemoryStore) LatestLedger(ctx context.Context) (*HistoryLedgers, error) {
+	ledgers, _ := m.Ledgers(ctx, 1, model.OrderDesc)
+	if len(ledgers) == 0 {
+		return nil, nil
+	}
+	return &ledgers[0], nil
+}
+
+func (m *MemoryStore) RecentLedgerCapacity(ctx context.Context, count int) ([]LedgerCapacity, error) {
+	ledgers := append([]HistoryLedgers{}, m.HistoryLedgers...)
+	ledgers = orderRows(ledgers, func(l *HistoryLedgers) int64 { return int64(l.Sequence) }, model.OrderDesc, count)
+	capacity := make([]LedgerCapacity, 0, len(ledgers))
+	for i := range ledgers {
+		capacity = append(capacity, LedgerCapacity{
+			Sequence:       ledgers[i].Sequence,
+			BaseFee:        ledgers[i].BaseFee,
+			OperationCount: ledgers[i].OperationCount,
+			MaxTxSetSize:   ledgers[i].MaxTxSetSize,
+		})
+	}
+	return capacity, nil
+}
+
+func (m *MemoryStore) TransactionByHash(ctx context.Context, hash string) (*HistoryTransactions, error) {
+	return firstRow(m.HistoryTransactions, func(t *HistoryTransactions) bool {
+		return t.TransactionHash == hash || (t.InnerTransactionHash != "" && t.InnerTransactionHash == hash)
+	}), nil
+}
+
+func (m *MemoryStore) TransactionByID(ctx context.Context, id int64) (*HistoryTransactions, error) {
+	return firstRow(m.HistoryTransactions, func(t *HistoryTransactions) bool { return t.ID == id }), nil
+}
+
+func (m *MemoryStore) LedgerTransactions(ctx context.Context, sequence int, limit int, order model.Order) ([]HistoryTransactions, error) {
+	transactions := filterRows(m.HistoryTransactions, func(t *HistoryTransactions) bool { return t.LedgerSequence == sequence })
+	return orderRows(transactions, func(t *HistoryTransactions) int64 { return t.ID }, order, limit), nil
+}
+
+func (m *MemoryStore) AccountTransactionIDs(ctx context.Context, accountID string, limit int, order model.Order) ([]int64, error) {
+	ids := append([]int64{}, m.Participants[accountID]...)
+	return orderRows(ids, func(id *int64) int64 { return *id }, order, limit), nil
+}
+
+func (m *MemoryStore) TransactionFees(ctx context.Context, firstLedger int, lastLedger int) ([]TransactionFee, error) {
+	fees := []TransactionFee{}
+	for i := range m.HistoryTransactions {
+		t := &m.HistoryTransactions[i]
+		if t.LedgerSequence < firstLedger || t.LedgerSequence > lastLedger {
+			continue
+		}
+		fees = append(fees, TransactionFee{
+			FeeCharged:           t.FeeCharged,
+			MaxFee:               t.MaxFee,
+			NewMaxFee:            t.NewMaxFee,
+			OperationCount:       int64(t.OperationCount),
+			InnerTransactionHash: t.InnerTransactionHash,
+		})
+	}
+	return fees, nil
+}
+
+func (m *MemoryStore) OperationByID(ctx context.Context, id int64) (*HistoryOperations, error) {
+	return firstRow(m.HistoryOperations, func(o *HistoryOperations) bool { return o.ID == id }), nil
+}
+
+func (m *MemoryStore) TransactionOperations(ctx context.Context, transactionID int64) ([]HistoryOperations, error) {
+	operations := filterRows(m.HistoryOperations, func(o *HistoryOperations) bool { return o.TransactionID == transactionID })
+	return orderRows(operations, func(o *HistoryOperations) int64 { return o.ID }, model.OrderAsc, -1), nil
+}
+
+func (m *MemoryStore) Account(ctx context.Context, accountID string) (*Account, error) {
+	return firstRow(m.Accounts, func(a *Account) bool { return a.AccountID == accountID }), nil
+}
+
+func (m *MemoryStore) TrustLines(ctx context.Context, accountID string) ([]TrustLine, error) {
+	trustLines := filterRows(m.AccountTrustLines, func(t *TrustLine) bool { return t.AccountID == accountID })
+	return orderRows(trustLines, func(t *TrustLine) int64 { return t.Balance }, model.OrderDesc, -1), nil
+}
+
+func (m *MemoryStore) Signers(ctx context.Context, accountID string) ([]AccountSigner, error) {
+	signers := filterRows(m.AccountSigners, func(s *AccountSigner) bool { return s.AccountID == accountID })
+	return orderRows(signers, func(s *AccountSigner) int64 { return int64(s.Weight) }, model.OrderAsc, -1), nil
+}
+
+func (m *MemoryStore) DataByName(ctx context.Context, accountID string, name string) ([]AccountData, error) {
+	return filterRows(m.AccountData, func(d *AccountData) bool { return d.AccountID == accountID && d.Name == name }), nil
+}
+
+func (m *MemoryStore) DataByPrefix(ctx context.Context, accountID string, prefix string) ([]AccountData, error) {
+	data := filterRows(m.AccountData, func(d *AccountData) bool {
+		return d.AccountID == accountID && strings.HasPrefix(d.Name, prefix)
+	})
+	sort.SliceStable(data, func(i, j int) bool { return data[i].Name < data[j].Name })
+	return data, nil
+}
+
+func (m *MemoryStore) Sponsoring(ctx context.Context, sponsor string, limit int) (*SponsoredEntries, error) {
+	entries := m.sponsoredEntries(func(accountID string, entrySponsor string) bool { return entrySponsor == sponsor }, limit)
+	entries.ClaimableBalances = filterRows(m.ClaimableBalances, func(c *ClaimableBalance) bool { return c.Sponsor == sponsor })
+	sort.SliceStable(entries.ClaimableBalances, func(i, j int) bool {
+		return entries.ClaimableBalances[i].ID < entries.ClaimableBalances[j].ID
+	})
+	entries.ClaimableBalances = truncate(entries.ClaimableBalances, limit)
+	return entries, nil
+}
+
+func (m *MemoryStore) SponsoredBy(ctx context.Context, accountID string, limit int) (*SponsoredEntries, error) {
+	return m.sponsoredEntries(func(entryAccount string, sponsor string) bool { return entryAccount == accountID && sponsor != "" }, limit), nil
+}
+
+func (m *MemoryStore) sponsoredEntries(match func(accountID string, sponsor string) bool, limit int) *SponsoredEntries {
+	entries := &SponsoredEntries{
+		Accounts:   filterRows(m.Accounts, func(a *Account) bool { return match(a.AccountID, a.Sponsor) }),
+		TrustLines: filterRows(m.AccountTrustLines, func(t *TrustLine) bool { return match(t.AccountID, t.Sponsor) }),
+		Data:       filterRows(m.AccountData, func(d *AccountData) bool { return match(d.AccountID, d.Sponsor) }),
+		Signers:    filterRows(m.AccountSigners, func(s *AccountSigner) bool { return match(s.AccountID, s.Sponsor) }),
+	}
+	sort.SliceStable(entries.Accounts, func(i, j int) bool {
+		return entries.Accounts[i].AccountID < entries.Accounts[j].AccountID
+	})
+	sort.SliceStable(entries.TrustLines, func(i, j int) bool {
+		a, b := entries.TrustLines[i], entries.TrustLines[j]
+		return a.AccountID < b.AccountID || a.AccountID == b.AccountID && a.AssetCode < b.AssetCode
+	})
+	sort.SliceStable(entries.Data, func(i, j int) bool {
+		a, b := entries.Data[i], entries.Data[j]
+		return a.AccountID < b.AccountID || a.AccountID == b.AccountID && a.Name < b.Name
+	})
+	sort.SliceStable(entries.Signers, func(i, j int) bool {
+		a, b := entries.Signers[i], entries.Signers[j]
+		return a.AccountID < b.AccountID || a.AccountID == b.AccountID && a.Signer < b.Signer
+	})
+	entries.Accounts = truncate(entries.Accounts, limit)
+	entries.TrustLines = truncate(entries.TrustLines, limit)
+	entries.Data = truncate(entries.Data, limit)
+	entries.Signers = truncate(entries.Signers, limit)
+	return entries
+}
+
+// A negative limit keeps every row
+func truncate[T any](rows []T, limit int) []T {
+	if limit >= 0 && len(rows) > limit {
+		return rows[:limit]
+	}
+	return rows
+}
+
+func (m *MemoryStore) OffersSelling(ctx context.Context, asset string, limit int) ([]Offer, error) {
+	return m.offers(func(o *Offer) bool { return o.SellingAsset == asset }, func(o Offer) string { return o.BuyingAsset }, limit), nil
+}
+
+func (m *MemoryStore) OffersBuying(ctx context.Context, asset string, limit int) ([]Offer, error) {
+	return m.offers(func(o *Offer) bool { return o.BuyingAsset == asset }, func(o Offer) string { return o.SellingAsset }, limit), nil
+}
+
+func (m *MemoryStore) offers(match func(*Offer) bool, counterpart func(Offer) string, limit int) []Offer {
+	offers := filterRows(m.Offers, match)
+	sort.SliceStable(offers, func(i, j int) bool {
+		a, b := offers[i], offers[j]
+		if counterpart(a) != counterpart(b) {
+			return counterpart(a) < counterpart(b)
+		}
+		return a.Price < b.Price || a.Price == b.Price && a.OfferID < b.OfferID
+	})
+
+	var limited []Offer
+	for start := 0; start < len(offers); {
+		end := start
+		for end < len(offers) && counterpart(offers[end]) == counterpart(offers[start]) {
+			end++
+		}
+		limited = append(limited, truncate(offers[start:end], limit)...)
+		start = end
+	}
+	return limited
+}
+
+func (m *MemoryStore) LiquidityPools(ctx context.Context, encoded string) ([]LiquidityPool, error) {
+	a, err := decodeAssetXDR(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return filterRows(m.Pools, func(pool *LiquidityPool) bool {
+		reserves, ok := parsePoolReserves(pool)
+		_, _, holds := reserves.sides(a)
+		return ok && holds
+	}), nil
+}
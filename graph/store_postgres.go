@@ -0,0 +1,351 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/owenjacob/hubblegraphql/database"
+	"github.com/owenjacob/hubblegraphql/graph/model"
+)
+
+// PostgresStore reads Horizon's tables. History queries go to a healthy read replica when
+// there is one, everything else to the primary.
+type PostgresStore struct {
+	DB *pgxpool.Pool
+	// Nil when there are no replicas
+	Replicas *database.Replicas
+}
+
+// NewPostgresStores uses one PostgresStore for every store
+func NewPostgresStores(db *pgxpool.Pool, replicas *database.Replicas) Stores {
+	s := &PostgresStore{DB: db, Replicas: replicas}
+	return Stores{Ledgers: s, Transactions: s, Operations: s, Accounts: s, OrderBook: s}
+}
+
+// A healthy read replica when there is one, otherwise the primary. Only for the history
+// tables, which a replica may lag behind on by a few ledgers.
+func (s *PostgresStore) historyDB() *pgxpool.Pool {
+	if db := s.Replicas.Reader(); db != nil {
+		return db
+	}
+	return s.DB
+}
+
+// Each statement selects exactly the columns of the row it is scanned into, by name. Columns
+// Horizon leaves NULL read as their zero value, so rows look the same whatever the schema
+// version.
+const (
+	ledgerColumns = `id, sequence, ledger_hash, COALESCE(previous_ledger_hash, '') AS previous_ledger_hash,
+		transaction_count, operation_count, closed_at,
+		COALESCE(created_at, '0001-01-01') AS created_at, COALESCE(updated_at, '0001-01-01') AS updated_at,
+		importer_version, total_coins, fee_pool, base_fee, base_reserve, max_tx_set_size, protocol_version,
+		COALESCE(ledger_header, '') AS ledger_header,
+		COALESCE(successful_transaction_count, 0) AS successful_transaction_count,
+		COALESCE(failed_transaction_count, 0) AS failed_transaction_count`
+
+	// time_bounds is an int8range, read as its text form and parsed by parseTimeBounds
+	transactionColumns = `id, transaction_hash, ledger_sequence, application_order, account, account_sequence,
+		max_fee, operation_count,
+		COALESCE(created_at, '0001-01-01') AS created_at, COALESCE(updated_at, '0001-01-01') AS updated_at,
+		tx_envelope, tx_result, tx_meta, tx_fee_meta, signatures, memo_type, COALESCE(memo, '') AS memo,
+		COALESCE(time_bounds::text, '') AS time_bounds, COALESCE(successful, false) AS successful,
+		COALESCE(fee_charged, 0) AS fee_charged, COALESCE(inner_transaction_hash, '') AS inner_transaction_hash,
+		COALESCE(fee_account, '') AS fee_account, COALESCE(inner_signatures, '{}') AS inner_signatures,
+		COALESCE(new_max_fee, 0) AS new_max_fee`
+
+	operationColumns = `id, transaction_id, application_order, type, details, source_account`
+
+	accountColumns = `account_id, balance, buying_liabilities, selling_liabilities, sequence_number, num_subentries,
+		COALESCE(inflation_destination, '') AS inflation_destination, flags, COALESCE(home_domain, '') AS home_domain,
+		master_weight, threshold_low, threshold_medium, threshold_high, last_modified_ledger,
+		COALESCE(sponsor, '') AS sponsor, num_sponsored, num_sponsoring`
+
+	trustLineColumns = `ledger_key, account_id, asset_type, asset_issuer, asset_code, balance, trust_line_limit,
+		buying_liabilities, selling_liabilities, flags, last_modified_ledger, COALESCE(sponsor, '') AS sponsor`
+
+	signerColumns = `account_id, signer, weight, COALESCE(sponsor, '') AS sponsor`
+
+	dataColumns = `ledger_key, account_id, name, value, last_modified_ledger, COALESCE(sponsor, '') AS sponsor`
+
+	claimableBalanceColumns = `id, COALESCE(sponsor, '') AS sponsor`
+
+	offerColumns = `seller_id, offer_id, selling_asset, buying_asset, amount, pricen, priced, price, flags,
+		last_modified_ledger, COALESCE(sponsor, '') AS sponsor`
+
+	liquidityPoolColumns = `id, type, fee, trustline_count, share_count, asset_reserves, last_modified_ledger`
+)
+
+// The same query sorted either way. Sort direction can't be a bind parameter, so both are
+// written out.
+type orderedQuery struct {
+	asc  string
+	desc string
+}
+
+func (q orderedQuery) by(order model.Order) string {
+	if order == model.OrderDesc {
+		return q.desc
+	}
+	return q.asc
+}
+
+const (
+	ledgerBySequenceQuery = `SELECT ` + ledgerColumns + ` FROM history_ledgers WHERE sequence = $1 ORDER BY id LIMIT 1`
+	latestLedgerQuery     = `SELECT ` + ledgerColumns + ` FROM history_ledgers ORDER BY id DESC LIMIT 1`
+	ledgerCapacityQuery   = `SELECT sequence, base_fee, operation_count, max_tx_set_size FROM history_ledgers
+		ORDER BY sequence DESC LIMIT $1`
+
+	transactionByHashQuery = `SELECT ` + transactionColumns + ` FROM history_transactions
+		WHERE transaction_hash = $1 OR inner_transaction_hash = $1 ORDER BY id LIMIT 1`
+	transactionByIDQuery = `SELECT ` + transactionColumns + ` FROM history_transactions WHERE id = $1 LIMIT 1`
+	transactionFeesQuery = `SELECT COALESCE(fee_charged, 0) AS fee_charged, max_fee, COALESCE(new_max_fee, 0) AS new_max_fee,
+		operation_count, COALESCE(inner_transaction_hash, '') AS inner_transaction_hash
+		FROM history_transactions WHERE ledger_sequence BETWEEN $1 AND $2`
+
+	operationByIDQuery         = `SELECT ` + operationColumns + ` FROM history_operations WHERE id = $1 LIMIT 1`
+	transactionOperationsQuery = `SELECT ` + operationColumns + ` FROM history_operations
+		WHERE transaction_id = $1 ORDER BY id`
+
+	accountQuery    = `SELECT ` + accountColumns + ` FROM accounts WHERE account_id = $1 LIMIT 1`
+	trustLinesQuery = `SELECT ` + trustLineColumns + ` FROM trust_lines WHERE account_id = $1 ORDER BY balance DESC`
+	signersQuery    = `SELECT ` + signerColumns + ` FROM accounts_signers WHERE account_id = $1 ORDER BY weight`
+	dataByNameQuery = `SELECT ` + dataColumns + ` FROM accounts_data
+		WHERE account_id = $1 AND name = $2 ORDER BY name`
+	dataByPrefixQuery = `SELECT ` + dataColumns + ` FROM accounts_data
+		WHERE account_id = $1 AND name LIKE $2 ORDER BY name`
+
+	// Prices are only comparable within a pair, so the limit applies to each counterpart asset
+	offersSellingQuery = `SELECT ` + offerColumns + ` FROM (
+		SELECT ` + offerColumns + `, row_number() OVER (PARTITION BY buying_asset ORDER BY price, offer_id) AS pair_rank
+		FROM offers WHERE selling_asset = $1 AND deleted = false) ranked
+		WHERE pair_rank <= $2 ORDER BY buying_asset, price, offer_id`
+	offersBuyingQuery = `SELECT ` + offerColumns + ` FROM (
+		SELECT ` + offerColumns + `, row_number() OVER (PARTITION BY selling_asset ORDER BY price, offer_id) AS pair_rank
+		FROM offers WHERE buying_asset = $1 AND deleted = false) ranked
+		WHERE pair_rank <= $2 ORDER BY selling_asset, price, offer_id`
+	// Reserves name their assets in canonical form or as base64 XDR, so both are matched
+	liquidityPoolsQuery = `SELECT ` + liquidityPoolColumns + ` FROM liquidity_pools
+		WHERE deleted = false AND (asset_reserves @> $1 OR asset_reserves @> $2) ORDER BY id`
+)
+
+var (
+	ledgersQuery = orderedQuery{
+		asc:  `SELECT ` + ledgerColumns + ` FROM history_ledgers ORDER BY id LIMIT $1`,
+		desc: `SELECT ` + ledgerColumns + ` FROM history_ledgers ORDER BY id DESC LIMIT $1`,
+	}
+	ledgerTransactionsQuery = orderedQuery{
+		asc: `SELECT ` + transactionColumns + ` FROM history_transactions
+			WHERE ledger_sequence = $1 ORDER BY id LIMIT $2`,
+		desc: `SELECT ` + transactionColumns + ` FROM history_transactions
+			WHERE ledger_sequence = $1 ORDER BY id DESC LIMIT $2`,
+	}
+	accountTransactionIDsQuery = orderedQuery{
+		asc: `SELECT p.history_transaction_id FROM history_accounts a
+			JOIN history_transaction_participants p ON p.history_account_id = a.id
+			WHERE a.address = $1 ORDER BY p.history_transaction_id LIMIT $2`,
+		desc: `SELECT p.history_transaction_id FROM history_accounts a
+			JOIN history_transaction_participants p ON p.history_account_id = a.id
+			WHERE a.address = $1 ORDER BY p.history_transaction_id DESC LIMIT $2`,
+	}
+)
+
+// Runs query and scans every row into a T by column name
+func collect[T any](ctx context.Context, db *pgxpool.Pool, query string, args ...any) ([]T, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowToStructByName[T])
+}
+
+// Like collect for a single row, which is nil when the query finds nothing
+func first[T any](ctx context.Context, db *pgxpool.Pool, query string, args ...any) (*T, error) {
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByName[T])
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return row, err
+}
+
+// LIMIT NULL is no limit at all, matching the in-memory store's negative limit
+func limitArg(limit int) *int {
+	if limit < 0 {
+		return nil
+	}
+	return &limit
+}
+
+func (s *PostgresStore) LedgerBySequence(ctx context.Context, sequence int) (*HistoryLedgers, error) {
+	return first[HistoryLedgers](ctx, s.historyDB(), ledgerBySequenceQuery, sequence)
+}
+
+func (s *PostgresStore) Ledgers(ctx context.Context, limit int, order model.Order) ([]HistoryLedgers, error) {
+	return collect[HistoryLedgers](ctx, s.historyDB(), ledgersQuery.by(order), limitArg(limit))
+}
+
+func (s *PostgresStore) LatestLedger(ctx context.Context) (*HistoryLedgers, error) {
+	return first[HistoryLedgers](ctx, s.historyDB(), latestLedgerQuery)
+}
+
+func (s *PostgresStore) RecentLedgerCapacity(ctx context.Context, count int) ([]LedgerCapacity, error) {
+	return collect[LedgerCapacity](ctx, s.historyDB(), ledgerCapacityQuery, limitArg(count))
+}
+
+func (s *PostgresStore) TransactionByHash(ctx context.Context, hash string) (*HistoryTransactions, error) {
+	return first[HistoryTransactions](ctx, s.historyDB(), transactionByHashQuery, hash)
+}
+
+func (s *PostgresStore) TransactionByID(ctx context.Context, id int64) (*HistoryTransactions, error) {
+	return first[HistoryTransactions](ctx, s.historyDB(), transactionByIDQuery, id)
+}
+
+func (s *PostgresStore) LedgerTransactions(ctx context.Context, sequence int, limit int, order model.Order) ([]HistoryTransactions, error) {
+	return collect[HistoryTransactions](ctx, s.historyDB(), ledgerTransactionsQuery.by(order), sequence, limitArg(limit))
+}
+
+func (s *PostgresStore) AccountTransactionIDs(ctx context.Context, accountID string, limit int, order model.Order) ([]int64, error) {
+	rows, err := s.historyDB().Query(ctx, accountTransactionIDsQuery.by(order), accountID, limitArg(limit))
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, pgx.RowTo[int64])
+}
+
+func (s *PostgresStore) TransactionFees(ctx context.Context, firstLedger int, lastLedger int) ([]TransactionFee, error) {
+	return collect[TransactionFee](ctx, s.historyDB(), transactionFeesQuery, firstLedger, lastLedger)
+}
+
+func (s *PostgresStore) OperationByID(ctx context.Context, id int64) (*HistoryOperations, error) {
+	return first[HistoryOperations](ctx, s.historyDB(), operationByIDQuery, id)
+}
+
+func (s *PostgresStore) TransactionOperations(ctx context.Context, transactionID int64) ([]HistoryOperations, error) {
+	return collect[HistoryOperations](ctx, s.historyDB(), transactionOperationsQuery, transactionID)
+}
+
+func (s *PostgresStore) Account(ctx context.Context, accountID string) (*Account, error) {
+	return first[Account](ctx, s.DB, accountQuery, accountID)
+}
+
+func (s *PostgresStore) TrustLines(ctx context.Context, accountID string) ([]TrustLine, error) {
+	return collect[TrustLine](ctx, s.DB, trustLinesQuery, accountID)
+}
+
+func (s *PostgresStore) Signers(ctx context.Context, accountID string) ([]AccountSigner, error) {
+	return collect[AccountSigner](ctx, s.DB, signersQuery, accountID)
+}
+
+func (s *PostgresStore) DataByName(ctx context.Context, accountID string, name string) ([]AccountData, error) {
+	return collect[AccountData](ctx, s.DB, dataByNameQuery, accountID, name)
+}
+
+// An empty prefix matches every name
+func (s *PostgresStore) DataByPrefix(ctx context.Context, accountID string, prefix string) ([]AccountData, error) {
+	return collect[AccountData](ctx, s.DB, dataByPrefixQuery, accountID, escapeLike(prefix)+"%")
+}
+
+// Statements for the entries of every state table with a sponsor column, all filtered the
+// same way
+type sponsoredQueries struct {
+	accounts          string
+	trustLines        string
+	data              string
+	signers           string
+	claimableBalances string
+}
+
+func newSponsoredQueries(condition string) sponsoredQueries {
+	return sponsoredQueries{
+		accounts:          `SELECT ` + accountColumns + ` FROM accounts WHERE ` + condition + ` ORDER BY account_id LIMIT $2`,
+		trustLines:        `SELECT ` + trustLineColumns + ` FROM trust_lines WHERE ` + condition + ` ORDER BY account_id, asset_code LIMIT $2`,
+		data:              `SELECT ` + dataColumns + ` FROM accounts_data WHERE ` + condition + ` ORDER BY account_id, name LIMIT $2`,
+		signers:           `SELECT ` + signerColumns + ` FROM accounts_signers WHERE ` + condition + ` ORDER BY account_id, signer LIMIT $2`,
+		claimableBalances: `SELECT ` + claimableBalanceColumns + ` FROM claimable_balances WHERE ` + condition + ` ORDER BY id LIMIT $2`,
+	}
+}
+
+var (
+	sponsoringQueries  = newSponsoredQueries(`sponsor = $1`)
+	sponsoredByQueries = newSponsoredQueries(`account_id = $1 AND sponsor IS NOT NULL`)
+)
+
+func (s *PostgresStore) Sponsoring(ctx context.Context, sponsor string, limit int) (*SponsoredEntries, error) {
+	return s.sponsoredEntries(ctx, sponsoringQueries, sponsor, limit, true)
+}
+
+func (s *PostgresStore) SponsoredBy(ctx context.Context, accountID string, limit int) (*SponsoredEntries, error) {
+	return s.sponsoredEntries(ctx, sponsoredByQueries, accountID, limit, false)
+}
+
+func (s *PostgresStore) sponsoredEntries(ctx context.Context, queries sponsoredQueries, accountID string, limit int, includeClaimable bool) (*SponsoredEntries, error) {
+	var err error
+	entries := &SponsoredEntries{}
+	if entries.Accounts, err = collect[Account](ctx, s.DB, queries.accounts, accountID, limitArg(limit)); err != nil {
+		return nil, err
+	}
+	if entries.TrustLines, err = collect[TrustLine](ctx, s.DB, queries.trustLines, accountID, limitArg(limit)); err != nil {
+		return nil, err
+	}
+	if entries.Data, err = collect[AccountData](ctx, s.DB, queries.data, accountID, limitArg(limit)); err != nil {
+		return nil, err
+	}
+	if entries.Signers, err = collect[AccountSigner](ctx, s.DB, queries.signers, accountID, limitArg(limit)); err != nil {
+		return nil, err
+	}
+	if includeClaimable {
+		if entries.ClaimableBalances, err = collect[ClaimableBalance](ctx, s.DB, queries.claimableBalances, accountID, limitArg(limit)); err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+func (s *PostgresStore) OffersSelling(ctx context.Context, asset string, limit int) ([]Offer, error) {
+	return collect[Offer](ctx, s.DB, offersSellingQuery, asset, limitArg(limit))
+}
+
+func (s *PostgresStore) OffersBuying(ctx context.Context, asset string, limit int) ([]Offer, error) {
+	return collect[Offer](ctx, s.DB, offersBuyingQuery, asset, limitArg(limit))
+}
+
+func (s *PostgresStore) LiquidityPools(ctx context.Context, encoded string) ([]LiquidityPool, error) {
+	a, err := decodeAssetXDR(encoded)
+	if err != nil {
+		return nil, err
+	}
+	canonical, _ := json.Marshal([]map[string]string{{"asset": a.String()}})
+	xdr, _ := json.Marshal([]map[string]string{{"asset": encoded}})
+	return collect[LiquidityPool](ctx, s.DB, liquidityPoolsQuery, string(canonical), string(xdr))
+}
+
+// ExportTransactions streams the transactions of the ledgers from first to last inclusive to w
+// as CSV with a header row, using COPY so rows never build up in memory
+func (s *PostgresStore) ExportTransactions(ctx context.Context, w io.Writer, firstLedger int, lastLedger int) error {
+	conn, err := s.historyDB().Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	// COPY takes no bind parameters, the bounds are plain integers
+	_, err = conn.Conn().PgConn().CopyTo(ctx, w, `COPY (
+		SELECT id, transaction_hash, ledger_sequence, application_order, account, account_sequence,
+			max_fee, COALESCE(fee_charged, 0) AS fee_charged, operation_count, COALESCE(successful, false) AS successful,
+			memo_type, COALESCE(memo, '') AS memo, signatures,
+			COALESCE(inner_transaction_hash, '') AS inner_transaction_hash, COALESCE(fee_account, '') AS fee_account,
+			COALESCE(new_max_fee, 0) AS new_max_fee
+		FROM history_transactions
+		WHERE ledger_sequence BETWEEN `+strconv.Itoa(firstLedger)+` AND `+strconv.Itoa(lastLedger)+`
+		ORDER BY id
+	) TO STDOUT WITH (FORMAT csv, HEADER)`)
+	return err
+}
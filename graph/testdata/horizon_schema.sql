@@ -0,0 +1,171 @@
+-- The subset of Horizon's schema that hubble reads, with Horizon's column types and
+-- nullability. Indexes and constraints that only matter to ingestion are left out.
+--
+-- Written by hand from the migrations in stellar/go services/horizon/internal/db2/schema as
+-- of Horizon 2.8, the protocol 18 release that added liquidity_pools. The protocol 19 columns
+-- of later releases (ledger_bounds, min_account_sequence and so on) aren't here, which is why
+-- preconditions are read from the envelope. Check any change against the same migrations.
+
+CREATE TABLE history_ledgers (
+    sequence integer NOT NULL,
+    ledger_hash character varying(64) NOT NULL,
+    previous_ledger_hash character varying(64),
+    transaction_count integer DEFAULT 0 NOT NULL,
+    operation_count integer DEFAULT 0 NOT NULL,
+    closed_at timestamp without time zone NOT NULL,
+    created_at timestamp without time zone,
+    updated_at timestamp without time zone,
+    id bigint PRIMARY KEY,
+    importer_version integer DEFAULT 1 NOT NULL,
+    total_coins bigint NOT NULL,
+    fee_pool bigint NOT NULL,
+    base_fee integer NOT NULL,
+    base_reserve integer NOT NULL,
+    max_tx_set_size integer NOT NULL,
+    protocol_version integer DEFAULT 0 NOT NULL,
+    ledger_header text,
+    successful_transaction_count integer,
+    failed_transaction_count integer
+);
+CREATE UNIQUE INDEX index_history_ledgers_on_sequence ON history_ledgers (sequence);
+
+CREATE TABLE history_transactions (
+    transaction_hash character varying(64) NOT NULL,
+    ledger_sequence integer NOT NULL,
+    application_order integer NOT NULL,
+    account character varying(64) NOT NULL,
+    account_sequence bigint NOT NULL,
+    max_fee bigint NOT NULL,
+    operation_count integer NOT NULL,
+    created_at timestamp without time zone,
+    updated_at timestamp without time zone,
+    id bigint PRIMARY KEY,
+    tx_envelope text NOT NULL,
+    tx_result text NOT NULL,
+    tx_meta text NOT NULL,
+    tx_fee_meta text NOT NULL,
+    signatures character varying(96)[] DEFAULT '{}'::character varying[] NOT NULL,
+    memo_type character varying DEFAULT 'none'::character varying NOT NULL,
+    memo character varying,
+    time_bounds int8range,
+    successful boolean,
+    fee_charged bigint,
+    inner_transaction_hash character varying(64),
+    fee_account character varying(64),
+    inner_signatures character varying(96)[],
+    new_max_fee bigint
+);
+CREATE INDEX by_ledger ON history_transactions (ledger_sequence, application_order);
+CREATE INDEX by_hash ON history_transactions (transaction_hash);
+CREATE INDEX by_inner_hash ON history_transactions (inner_transaction_hash);
+
+CREATE TABLE history_operations (
+    id bigint PRIMARY KEY,
+    transaction_id bigint NOT NULL,
+    application_order integer NOT NULL,
+    type integer NOT NULL,
+    details jsonb,
+    source_account character varying(64) DEFAULT ''::character varying NOT NULL
+);
+CREATE INDEX index_history_operations_on_transaction_id ON history_operations (transaction_id);
+
+CREATE TABLE history_accounts (
+    id bigserial PRIMARY KEY,
+    address character varying(64) UNIQUE
+);
+
+CREATE TABLE history_transaction_participants (
+    history_transaction_id bigint NOT NULL,
+    history_account_id bigint NOT NULL
+);
+CREATE INDEX hist_tx_p_id ON history_transaction_participants (history_account_id, history_transaction_id);
+
+CREATE TABLE history_operation_participants (
+    history_operation_id bigint NOT NULL,
+    history_account_id bigint NOT NULL
+);
+
+CREATE TABLE accounts (
+    account_id character varying(56) PRIMARY KEY,
+    balance bigint NOT NULL,
+    buying_liabilities bigint NOT NULL,
+    selling_liabilities bigint NOT NULL,
+    sequence_number bigint NOT NULL,
+    num_subentries integer NOT NULL,
+    inflation_destination character varying(56) NOT NULL,
+    flags integer NOT NULL,
+    home_domain character varying(32) NOT NULL,
+    master_weight smallint NOT NULL,
+    threshold_low smallint NOT NULL,
+    threshold_medium smallint NOT NULL,
+    threshold_high smallint NOT NULL,
+    last_modified_ledger integer NOT NULL,
+    sponsor text,
+    num_sponsored integer DEFAULT 0 NOT NULL,
+    num_sponsoring integer DEFAULT 0 NOT NULL
+);
+
+CREATE TABLE trust_lines (
+    ledger_key character varying(150) PRIMARY KEY,
+    account_id character varying(56) NOT NULL,
+    asset_type integer NOT NULL,
+    asset_issuer character varying(56) NOT NULL,
+    asset_code character varying(12) NOT NULL,
+    balance bigint NOT NULL,
+    trust_line_limit bigint NOT NULL,
+    buying_liabilities bigint NOT NULL,
+    selling_liabilities bigint NOT NULL,
+    flags integer NOT NULL,
+    last_modified_ledger integer NOT NULL,
+    sponsor text
+);
+CREATE INDEX trust_lines_by_account_id ON trust_lines (account_id);
+
+CREATE TABLE accounts_signers (
+    account_id character varying(64) NOT NULL,
+    signer character varying(64) NOT NULL,
+    weight integer NOT NULL,
+    sponsor text,
+    PRIMARY KEY (signer, account_id)
+);
+
+CREATE TABLE accounts_data (
+    ledger_key character varying(150) PRIMARY KEY,
+    account_id character varying(56) NOT NULL,
+    name character varying(64) NOT NULL,
+    value character varying(90) NOT NULL,
+    last_modified_ledger integer NOT NULL,
+    sponsor text
+);
+CREATE UNIQUE INDEX accounts_data_account_id_name ON accounts_data (account_id, name);
+
+CREATE TABLE claimable_balances (
+    id text PRIMARY KEY,
+    sponsor text
+);
+
+CREATE TABLE offers (
+    seller_id character varying(56) NOT NULL,
+    offer_id bigint PRIMARY KEY,
+    selling_asset text NOT NULL,
+    buying_asset text NOT NULL,
+    amount bigint NOT NULL,
+    pricen integer NOT NULL,
+    priced integer NOT NULL,
+    price double precision NOT NULL,
+    flags integer NOT NULL,
+    deleted boolean DEFAULT false,
+    last_modified_ledger integer NOT NULL,
+    sponsor text
+);
+
+CREATE TABLE liquidity_pools (
+    id text PRIMARY KEY,
+    type smallint NOT NULL,
+    fee integer NOT NULL,
+    trustline_count bigint NOT NULL,
+    share_count bigint DEFAULT 0 NOT NULL,
+    asset_reserves jsonb NOT NULL,
+    last_modified_ledger integer NOT NULL,
+    deleted boolean DEFAULT false NOT NULL
+);
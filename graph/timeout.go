@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/owenjacob/hubblegraphql/apierror"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Timeout gives each operation a deadline, which is passed on to its resolvers and their
+// statements. Overrides are keyed by root field, and an operation selecting several root
+// fields gets the longest of their timeouts.
+type Timeout struct {
+	Default   time.Duration
+	Overrides map[string]time.Duration
+}
+
+type timeoutKey struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = Timeout{}
+
+func (Timeout) ExtensionName() string {
+	return "Timeout"
+}
+
+func (Timeout) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (t Timeout) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	timeout := t.timeout(graphql.GetOperationContext(ctx).Operation)
+	ctx, cancel := context.WithTimeout(context.WithValue(ctx, timeoutKey{}, timeout), timeout)
+	defer cancel()
+	return next(ctx)
+}
+
+func (Timeout) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	res, err := next(ctx)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		timeout, _ := ctx.Value(timeoutKey{}).(time.Duration)
+		// Whatever the resolver returned, usually a cancelled statement, is down to the deadline
+		return res, &apierror.Error{
+			Code:    apierror.Timeout,
+			Message: "Operation timed out after " + timeout.String(),
+			Details: map[string]interface{}{"timeout": timeout.String()},
+		}
+	}
+	return res, err
+}
+
+func (t Timeout) timeout(op *ast.OperationDefinition) time.Duration {
+	if op == nil {
+		return t.Default
+	}
+	timeout := time.Duration(0)
+	for _, field := range rootFields(op.SelectionSet) {
+		fieldTimeout, ok := t.Overrides[field]
+		if !ok {
+			fieldTimeout = t.Default
+		}
+		if fieldTimeout > timeout {
+			timeout = fieldTimeout
+		}
+	}
+	if timeout == 0 {
+		return t.Default
+	}
+	return timeout
+}
+
+func rootFields(selectionSet ast.SelectionSet) []string {
+	var fields []string
+	for _, selection := range selectionSet {
+		switch s := selection.(type) {
+		case *ast.Field:
+			fields = append(fields, s.Name)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				fields = append(fields, rootFields(s.Definition.SelectionSet)...)
+			}
+		case *ast.InlineFragment:
+			fields = append(fields, rootFields(s.SelectionSet)...)
+		}
+	}
+	return fields
+}
@@ -0,0 +1,122 @@
+package graph
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/owenjacob/hubblegraphql/tracing"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+const (
+	parentTraceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+	parentSpanID  = "00f067aa0ba902b7"
+)
+
+// A query sent with a traceparent header produces a request span continuing the client's
+// trace, with the operation, field and, against Postgres, SQL spans nested under it
+func TestTracing(t *testing.T) {
+	t.Run("memory", func(t *testing.T) {
+		spans := traceQuery(t, NewMemoryStores(fixtures()))
+		checkRequestSpans(t, spans)
+	})
+	t.Run("postgres", func(t *testing.T) {
+		pool := openTestPostgres(t, fixtures(), func(config *pgxpool.Config) {
+			config.ConnConfig.Tracer = tracing.QueryTracer{}
+		})
+		spans := traceQuery(t, NewPostgresStores(pool, nil))
+		field := checkRequestSpans(t, spans)
+
+		sql := findSpan(t, spans, "SELECT history_ledgers")
+		if sql.Parent.SpanID() != field.SpanContext.SpanID() {
+			t.Errorf("SQL span's parent is %s, not the field span", sql.Parent.SpanID())
+		}
+		attributes := map[string]string{}
+		for _, attribute := range sql.Attributes {
+			attributes[string(attribute.Key)] = attribute.Value.Emit()
+		}
+		if !strings.Contains(attributes["db.statement"], "FROM history_ledgers") {
+			t.Errorf("db.statement is %q", attributes["db.statement"])
+		}
+		if attributes["db.rows_affected"] != "1" {
+			t.Errorf("db.rows_affected is %q, want 1", attributes["db.rows_affected"])
+		}
+	})
+}
+
+// Runs a ledger lookup through the request middleware and the tracing extension, recording
+// the spans in memory
+func traceQuery(t *testing.T, stores Stores) tracetest.SpanStubs {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	provider := tracing.NewProvider(sdktrace.NewSimpleSpanProcessor(exporter), "hubble-test", 1)
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	// Setup installs the propagators even with tracing disabled
+	if _, err := tracing.Setup(context.Background(), tracing.Config{}); err != nil {
+		t.Fatal(err)
+	}
+
+	h := testHandler(stores)
+	h.Use(tracing.Extension{})
+	body, _ := json.Marshal(map[string]string{"query": "query Lookup { ledger(number: 103) { sequence } }"})
+	req := httptest.NewRequest(http.MethodPost, "/query", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("traceparent", "00-"+parentTraceID+"-"+parentSpanID+"-01")
+	res := httptest.NewRecorder()
+	tracing.Handler(h).ServeHTTP(res, req)
+	if res.Code != http.StatusOK || strings.Contains(res.Body.String(), `"errors"`) {
+		t.Fatalf("status %d\n%s", res.Code, res.Body.String())
+	}
+
+	if err := provider.ForceFlush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return exporter.GetSpans()
+}
+
+// Checks the request, operation and field spans and returns the field span
+func checkRequestSpans(t *testing.T, spans tracetest.SpanStubs) tracetest.SpanStub {
+	t.Helper()
+	for _, span := range spans {
+		if span.SpanContext.TraceID().String() != parentTraceID {
+			t.Errorf("%s is in trace %s, not the client's", span.Name, span.SpanContext.TraceID())
+		}
+	}
+
+	request := findSpan(t, spans, "POST /query")
+	if request.Parent.SpanID().String() != parentSpanID || !request.Parent.IsRemote() {
+		t.Errorf("request span's parent is %s, not the client's span", request.Parent.SpanID())
+	}
+	operation := findSpan(t, spans, "graphql.operation Lookup")
+	if operation.Parent.SpanID() != request.SpanContext.SpanID() {
+		t.Errorf("operation span's parent is %s, not the request span", operation.Parent.SpanID())
+	}
+	field := findSpan(t, spans, "Query.ledger")
+	if field.Parent.SpanID() != operation.SpanContext.SpanID() {
+		t.Errorf("field span's parent is %s, not the operation span", field.Parent.SpanID())
+	}
+	return field
+}
+
+func findSpan(t *testing.T, spans tracetest.SpanStubs, name string) tracetest.SpanStub {
+	t.Helper()
+	var names []string
+	for _, span := range spans {
+		if span.Name == name {
+			return span
+		}
+		names = append(names, span.Name)
+	}
+	t.Fatalf("no %q span among %v", name, names)
+	return tracetest.SpanStub{}
+}
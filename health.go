@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/julienschmidt/httprouter"
+	"github.com/owenjacob/hubblegraphql/graph"
+)
+
+// Build version, set at link time with -ldflags "-X main.version=..."
+var version = "dev"
+
+// Tables every resolver depends on. Readiness fails if Horizon has not migrated them yet.
+var requiredTables = []string{
+	"history_ledgers",
+	"history_transactions",
+	"history_operations",
+	"history_accounts",
+	"accounts",
+	"trust_lines",
+}
+
+type ingestionStatus struct {
+	LatestLedger    int       `json:"latestLedger"`
+	ClosedAt        time.Time `json:"closedAt"`
+	LagSeconds      float64   `json:"lagSeconds"`
+	ProtocolVersion int       `json:"protocolVersion"`
+	BuildVersion    string    `json:"buildVersion"`
+}
+
+// Answers as long as the process is serving requests
+func healthzHandler() httprouter.Handle {
+	return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	}
+}
+
+// Checks the liveness endpoint of a server running in the same container, so the image's health
+// check doesn't depend on curl being installed
+func healthcheck(listenAddr string) error {
+	host, port, err := net.SplitHostPort(listenAddr)
+	if err != nil {
+		return fmt.Errorf("listenAddr %q: %w", listenAddr, err)
+	}
+	if host == "" || net.ParseIP(host).IsUnspecified() {
+		host = "localhost"
+	}
+
+	client := http.Client{Timeout: 5 * time.Second}
+	res, err := client.Get("http://" + net.JoinHostPort(host, port) + "/healthz")
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("healthz returned %s", res.Status)
+	}
+	return nil
+}
+
+// Ready once the database is reachable, holds the Horizon schema and ingestion is keeping up
+func readyzHandler(dbConnection *pgxpool.Pool, maxIngestionLag time.Duration) httprouter.Handle {
+	return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+		ctx, cancel := context.WithTimeout(req.Context(), 5*time.Second)
+		defer cancel()
+
+		if err := dbConnection.Ping(ctx); err != nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "unavailable", "reason": "database unreachable"})
+			return
+		}
+		for _, table := range requiredTables {
+			var exists bool
+			err := dbConnection.QueryRow(ctx, "SELECT to_regclass($1) IS NOT NULL", table).Scan(&exists)
+			if err != nil || !exists {
+				writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "unavailable", "reason": "missing table " + table})
+				return
+			}
+		}
+
+		status, err := latestIngestionStatus(ctx, dbConnection)
+		if err != nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "unavailable", "reason": "no ingested ledgers"})
+			return
+		}
+		if status.LagSeconds > maxIngestionLag.Seconds() {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"status": "unavailable", "reason": "ingestion lagging"})
+			return
+		}
+		writeJSON(w, http.StatusOK, map[string]string{"status": "ready"})
+	}
+}
+
+// Reports the latest ingested ledger and how far behind the network it is
+func statusHandler(dbConnection *pgxpool.Pool) httprouter.Handle {
+	return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
+		status, err := latestIngestionStatus(req.Context(), dbConnection)
+		if err != nil {
+			writeJSON(w, http.StatusServiceUnavailable, map[string]string{"buildVersion": version, "error": "no ingested ledgers"})
+			return
+		}
+		writeJSON(w, http.StatusOK, status)
+	}
+}
+
+func latestIngestionStatus(ctx context.Context, dbConnection *pgxpool.Pool) (*ingestionStatus, error) {
+	ledger := graph.HistoryLedgers{}
+	err := dbConnection.QueryRow(ctx, "SELECT sequence, closed_at, protocol_version FROM history_ledgers ORDER BY sequence DESC LIMIT 1").
+		Scan(&ledger.Sequence, &ledger.ClosedAt, &ledger.ProtocolVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ingestionStatus{
+		LatestLedger:    ledger.Sequence,
+		ClosedAt:        ledger.ClosedAt,
+		LagSeconds:      time.Since(ledger.ClosedAt).Seconds(),
+		ProtocolVersion: ledger.ProtocolVersion,
+		BuildVersion:    version,
+	}, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,70 @@
+package logging
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Extension copies the operation name, a hash of its variables and any error codes onto the
+// request's access log entry
+type Extension struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = Extension{}
+
+func (Extension) ExtensionName() string {
+	return "Logging"
+}
+
+func (Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	response := next(ctx)
+	info, ok := ctx.Value(contextKey{}).(*requestInfo)
+	if !ok || response == nil {
+		return response
+	}
+
+	oc := graphql.GetOperationContext(ctx)
+	name := oc.OperationName
+	if name == "" && oc.Operation != nil {
+		name = oc.Operation.Name
+	}
+	if name == "" {
+		name = "anonymous"
+	}
+
+	info.mu.Lock()
+	defer info.mu.Unlock()
+	info.operation = name
+	info.variablesHash = hashVariables(oc.Variables)
+	for _, err := range response.Errors {
+		code, _ := err.Extensions["code"].(string)
+		if code == "" {
+			code = "UNKNOWN"
+		}
+		info.errorCodes = append(info.errorCodes, code)
+	}
+	return response
+}
+
+// Maps are encoded with sorted keys, so equal variables always hash the same
+func hashVariables(variables map[string]interface{}) string {
+	if len(variables) == 0 {
+		return ""
+	}
+	encoded, err := json.Marshal(variables)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:8])
+}
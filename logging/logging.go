@@ -0,0 +1,57 @@
+// Package logging writes JSON structured logs: one access log entry per request, sampled SQL
+// statements and anything else the server reports. Query variables are only ever logged as a
+// hash so client data stays out of the logs.
+package logging
+
+import (
+	"context"
+	"io"
+	"log/slog"
+)
+
+type contextKey struct{}
+
+// New returns a JSON logger that drops entries below level, one of debug, info, warn or error
+func New(w io.Writer, level string) *slog.Logger {
+	var l slog.Level
+	switch level {
+	case "debug":
+		l = slog.LevelDebug
+	case "warn":
+		l = slog.LevelWarn
+	case "error":
+		l = slog.LevelError
+	default:
+		l = slog.LevelInfo
+	}
+	return slog.New(contextHandler{slog.NewJSONHandler(w, &slog.HandlerOptions{Level: l})})
+}
+
+// FromContext returns the request scoped logger, which tags every entry with the request id
+func FromContext(ctx context.Context) *slog.Logger {
+	if info, ok := ctx.Value(contextKey{}).(*requestInfo); ok {
+		return slog.Default().With("request_id", info.requestID)
+	}
+	return slog.Default()
+}
+
+// Tags entries logged through the Context methods, e.g. InfoContext, with the id of the
+// request the context belongs to
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if info, ok := ctx.Value(contextKey{}).(*requestInfo); ok {
+		r.AddAttrs(slog.String("request_id", info.requestID))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return contextHandler{h.Handler.WithAttrs(attrs)}
+}
+
+func (h contextHandler) WithGroup(name string) slog.Handler {
+	return contextHandler{h.Handler.WithGroup(name)}
+}
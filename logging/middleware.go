@@ -0,0 +1,102 @@
+package logging
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/owenjacob/hubblegraphql/clientip"
+)
+
+// Details gathered while a request is served and written to its access log entry
+type requestInfo struct {
+	requestID string
+
+	mu            sync.Mutex
+	operation     string
+	variablesHash string
+	errorCodes    []string
+}
+
+// Middleware assigns each request an id, echoed in the X-Request-ID header, and writes an access
+// log entry once the response is complete. A request id sent by the client or a load balancer
+// is kept so logs can be correlated across hops.
+func Middleware(logger *slog.Logger, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+		requestID := req.Header.Get("X-Request-ID")
+		if requestID == "" || len(requestID) > 128 {
+			requestID = newRequestID()
+		}
+		w.Header().Set("X-Request-ID", requestID)
+
+		info := &requestInfo{requestID: requestID}
+		ctx := context.WithValue(req.Context(), contextKey{}, info)
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, req.WithContext(ctx))
+
+		info.mu.Lock()
+		defer info.mu.Unlock()
+		attrs := []any{
+			"method", req.Method,
+			"path", req.URL.Path,
+			"status", recorder.status,
+			"duration_ms", float64(time.Since(start).Microseconds()) / 1000,
+			"client_ip", clientip.FromRequest(req),
+		}
+		if info.operation != "" {
+			attrs = append(attrs, "operation", info.operation)
+		}
+		if info.variablesHash != "" {
+			attrs = append(attrs, "variables_hash", info.variablesHash)
+		}
+		if len(info.errorCodes) > 0 {
+			attrs = append(attrs, "error_codes", info.errorCodes)
+		}
+		logger.InfoContext(ctx, "request", attrs...)
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Websocket upgrades take over the connection, which needs the underlying writer's Hijacker
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	r.status = http.StatusSwitchingProtocols
+	return hijacker.Hijack()
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
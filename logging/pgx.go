@@ -0,0 +1,55 @@
+package logging
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SQLTracer logs statements at debug level, only for a sampled fraction of queries and without
+// their bound values. Failed queries are always logged. Entries are logged with the query's
+// context so they carry the id of the request that ran it.
+type SQLTracer struct {
+	Logger     *slog.Logger
+	SampleRate float64
+}
+
+var _ pgx.QueryTracer = SQLTracer{}
+
+type sqlQueryKey struct{}
+
+type sqlQuery struct {
+	statement string
+	startedAt time.Time
+	sampled   bool
+}
+
+func (t SQLTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	sampled := t.Logger.Enabled(ctx, slog.LevelDebug) && rand.Float64() < t.SampleRate
+	return context.WithValue(ctx, sqlQueryKey{}, sqlQuery{statement: data.SQL, startedAt: time.Now(), sampled: sampled})
+}
+
+func (t SQLTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	query, ok := ctx.Value(sqlQueryKey{}).(sqlQuery)
+	if !ok {
+		return
+	}
+	duration := float64(time.Since(query.startedAt).Microseconds()) / 1000
+
+	// A lookup that finds nothing isn't a failure
+	if data.Err != nil && !errors.Is(data.Err, pgx.ErrNoRows) {
+		t.Logger.WarnContext(ctx, "sql", "statement", query.statement, "duration_ms", duration, "error", data.Err.Error())
+		return
+	}
+	if query.sampled {
+		t.Logger.DebugContext(ctx, "sql",
+			"statement", query.statement,
+			"duration_ms", duration,
+			"rows", data.CommandTag.RowsAffected(),
+		)
+	}
+}
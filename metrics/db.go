@@ -0,0 +1,85 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/owenjacob/hubblegraphql/database"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type queryKey struct{}
+
+type query struct {
+	table     string
+	startedAt time.Time
+}
+
+// QueryTracer times every query by the table it reads or writes
+type QueryTracer struct{}
+
+var _ pgx.QueryTracer = QueryTracer{}
+
+func (QueryTracer) TraceQueryStart(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	return context.WithValue(ctx, queryKey{}, query{table: database.Table(data.SQL), startedAt: time.Now()})
+}
+
+func (QueryTracer) TraceQueryEnd(ctx context.Context, conn *pgx.Conn, data pgx.TraceQueryEndData) {
+	q, ok := ctx.Value(queryKey{}).(query)
+	if !ok {
+		return
+	}
+	dbQueryDuration.WithLabelValues(q.table).Observe(time.Since(q.startedAt).Seconds())
+}
+
+// RegisterPool exports the connection pool statistics under the given database name, which
+// must be unique. The metric names are the ones database/sql pools export, so dashboards
+// built for them keep working.
+func RegisterPool(pool *pgxpool.Pool, name string) {
+	labels := prometheus.Labels{"db_name": name}
+	desc := func(name string, help string) *prometheus.Desc {
+		return prometheus.NewDesc(name, help, nil, labels)
+	}
+	Registry.MustRegister(&poolCollector{
+		pool:           pool,
+		maxOpen:        desc("go_sql_max_open_connections", "Maximum number of open connections to the database."),
+		open:           desc("go_sql_open_connections", "The number of established connections both in use and idle."),
+		inUse:          desc("go_sql_in_use_connections", "The number of connections currently in use."),
+		idle:           desc("go_sql_idle_connections", "The number of idle connections."),
+		waitCount:      desc("go_sql_wait_count_total", "The total number of connections waited for."),
+		waitDuration:   desc("go_sql_wait_duration_seconds_total", "The total time blocked waiting for a new connection."),
+		lifetimeClosed: desc("go_sql_max_lifetime_closed_total", "The total number of connections closed due to SetConnMaxLifetime."),
+		idleClosed:     desc("go_sql_max_idle_time_closed_total", "The total number of connections closed due to SetConnMaxIdleTime."),
+	})
+}
+
+type poolCollector struct {
+	pool           *pgxpool.Pool
+	maxOpen        *prometheus.Desc
+	open           *prometheus.Desc
+	inUse          *prometheus.Desc
+	idle           *prometheus.Desc
+	waitCount      *prometheus.Desc
+	waitDuration   *prometheus.Desc
+	lifetimeClosed *prometheus.Desc
+	idleClosed     *prometheus.Desc
+}
+
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(c.maxOpen, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.open, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.inUse, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idle, prometheus.GaugeValue, float64(stat.IdleConns()))
+	// An acquire that found no idle connection had to wait for one
+	ch <- prometheus.MustNewConstMetric(c.waitCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.waitDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.lifetimeClosed, prometheus.CounterValue, float64(stat.MaxLifetimeDestroyCount()))
+	ch <- prometheus.MustNewConstMetric(c.idleClosed, prometheus.CounterValue, float64(stat.MaxIdleDestroyCount()))
+}
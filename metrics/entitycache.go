@@ -0,0 +1,39 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	entityCacheRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hubble_entity_cache_requests_total",
+		Help: "Entity cache lookups by kind of entity and result, hit or miss.",
+	}, []string{"kind", "result"})
+
+	entityCacheEvictions = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "hubble_entity_cache_evictions_total",
+		Help: "Entities dropped from the cache to stay under its memory cap.",
+	})
+
+	entityCacheBytes = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "hubble_entity_cache_bytes",
+		Help: "Estimated memory held by cached entities.",
+	})
+)
+
+func init() {
+	Registry.MustRegister(entityCacheRequests, entityCacheEvictions, entityCacheBytes)
+}
+
+// EntityCacheLookup counts a lookup of the given kind of entity
+func EntityCacheLookup(kind string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	entityCacheRequests.WithLabelValues(kind, result).Inc()
+}
+
+// EntityCacheEvicted counts entities evicted and records the memory still in use
+func EntityCacheEvicted(evicted int, bytes int64) {
+	entityCacheEvictions.Add(float64(evicted))
+	entityCacheBytes.Set(float64(bytes))
+}
@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// Extension records operation and field metrics from inside the gqlgen handler. Operation
+// names are chosen by clients, so only those in Operations become label values and the rest
+// are counted together as "other".
+type Extension struct {
+	Operations map[string]bool
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = Extension{}
+
+func (Extension) ExtensionName() string {
+	return "Metrics"
+}
+
+func (Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// Subscriptions count as active from when they start until their stream ends or the client
+// goes away, whichever comes first
+func (Extension) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil || oc.Operation.Operation != ast.Subscription {
+		return next(ctx)
+	}
+
+	activeSubscriptions.Inc()
+	var once sync.Once
+	done := func() { once.Do(activeSubscriptions.Dec) }
+	go func() {
+		<-ctx.Done()
+		done()
+	}()
+
+	responses := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		response := responses(ctx)
+		if response == nil {
+			done()
+		}
+		return response
+	}
+}
+
+func (e Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	oc := graphql.GetOperationContext(ctx)
+	response := next(ctx)
+	if response == nil {
+		return nil
+	}
+
+	name, operationType := operationLabels(oc, e.Operations)
+	operationsTotal.WithLabelValues(name, operationType).Inc()
+	operationDuration.WithLabelValues(name).Observe(time.Since(oc.Stats.OperationStart).Seconds())
+	for _, err := range response.Errors {
+		code, _ := err.Extensions["code"].(string)
+		if code == "" {
+			code = "UNKNOWN"
+		}
+		errorsTotal.WithLabelValues(code).Inc()
+	}
+	return response
+}
+
+// Only fields with a resolver are timed, plain struct fields cost nothing worth measuring
+func (Extension) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsMethod {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+	fieldDuration.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+	return res, err
+}
+
+func operationLabels(oc *graphql.OperationContext, known map[string]bool) (string, string) {
+	name := oc.OperationName
+	if name == "" && oc.Operation != nil {
+		name = oc.Operation.Name
+	}
+	switch {
+	case name == "":
+		name = "anonymous"
+	case !known[name]:
+		name = "other"
+	}
+
+	operationType := "unknown"
+	if oc.Operation != nil {
+		operationType = string(oc.Operation.Operation)
+	}
+	return name, operationType
+}
@@ -0,0 +1,67 @@
+// Package metrics exposes Prometheus metrics for GraphQL operations, resolvers and the
+// database queries behind them.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Registry holds every metric served by Handler
+var Registry = prometheus.NewRegistry()
+
+var (
+	operationsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hubble_graphql_operations_total",
+		Help: "GraphQL operation responses by operation name and type.",
+	}, []string{"operation", "type"})
+
+	operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "hubble_graphql_operation_duration_seconds",
+		Help:    "Time from the start of a GraphQL operation until its response was ready.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation"})
+
+	fieldDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "hubble_graphql_field_duration_seconds",
+		Help:    "Time spent in each resolver backed field.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"object", "field"})
+
+	errorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "hubble_graphql_errors_total",
+		Help: "Errors returned to clients by error code.",
+	}, []string{"code"})
+
+	activeSubscriptions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "hubble_graphql_active_subscriptions",
+		Help: "Subscriptions currently streaming to clients.",
+	})
+
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "hubble_db_query_duration_seconds",
+		Help:    "Database query durations by table.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"table"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		operationsTotal,
+		operationDuration,
+		fieldDuration,
+		errorsTotal,
+		activeSubscriptions,
+		dbQueryDuration,
+	)
+}
+
+// Handler serves the registry in the Prometheus text format
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,39 @@
+package persisted
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+const errNotAllowed = "OPERATION_NOT_ALLOWED"
+
+// AllowList rejects any document that isn't in the manifest. It must be added after the
+// AutomaticPersistedQuery extension so that documents sent by hash have been looked up.
+type AllowList struct {
+	Manifest Manifest
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationParameterMutator
+} = AllowList{}
+
+func (AllowList) ExtensionName() string {
+	return "AllowList"
+}
+
+func (AllowList) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (a AllowList) MutateOperationParameters(ctx context.Context, params *graphql.RawParams) *gqlerror.Error {
+	if _, ok := a.Manifest[Hash(params.Query)]; ok {
+		return nil
+	}
+	err := gqlerror.Errorf("operation is not in the manifest of allowed operations")
+	errcode.Set(err, errNotAllowed)
+	return err
+}
@@ -0,0 +1,122 @@
+// Package persisted stores the query documents behind automatic persisted queries, and
+// restricts which documents may run when the server is locked to a manifest.
+package persisted
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Manifest maps the SHA-256 of each registered document to the document. Each .graphql
+// file is one document, hashed exactly as it is on disk, so clients built from the same files
+// can send just the hash.
+type Manifest map[string]string
+
+// LoadManifest reads every .graphql file under dir. Documents that don't validate against the
+// schema stop the server from starting rather than failing on first use.
+func LoadManifest(dir string, schema *ast.Schema) (Manifest, error) {
+	manifest := Manifest{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(info.Name(), ".graphql") {
+			return nil
+		}
+
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		query := string(contents)
+		if _, errs := gqlparser.LoadQuery(schema, query); len(errs) > 0 {
+			return fmt.Errorf("%s: %v", path, errs[0])
+		}
+		manifest[Hash(query)] = query
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("loading operation manifest: %v", err)
+	}
+	if len(manifest) == 0 {
+		return nil, fmt.Errorf("loading operation manifest: no .graphql files in %s", dir)
+	}
+	return manifest, nil
+}
+
+// OperationNames lists the names of the operations in the manifest's documents
+func (m Manifest) OperationNames() []string {
+	var names []string
+	for _, query := range m {
+		doc, err := parser.ParseQuery(&ast.Source{Input: query})
+		if err != nil {
+			continue
+		}
+		for _, operation := range doc.Operations {
+			if operation.Name != "" {
+				names = append(names, operation.Name)
+			}
+		}
+	}
+	return names
+}
+
+// Hash is the hex SHA-256 used as the persisted query id
+func Hash(query string) string {
+	sum := sha256.Sum256([]byte(query))
+	return hex.EncodeToString(sum[:])
+}
+
+var _ graphql.Cache = Manifest{}
+
+func (m Manifest) Get(ctx context.Context, key string) (interface{}, bool) {
+	query, ok := m[key]
+	return query, ok
+}
+
+// The manifest only changes on restart, so documents registered by clients are dropped
+func (m Manifest) Add(ctx context.Context, key string, value interface{}) {}
+
+// WithManifest serves manifest documents ahead of cache, so clients can send their hashes
+// without registering them first
+func WithManifest(manifest Manifest, cache graphql.Cache) graphql.Cache {
+	return layered{manifest, cache}
+}
+
+type layered struct {
+	manifest Manifest
+	cache    graphql.Cache
+}
+
+func (l layered) Get(ctx context.Context, key string) (interface{}, bool) {
+	if query, ok := l.manifest.Get(ctx, key); ok {
+		return query, true
+	}
+	return l.cache.Get(ctx, key)
+}
+
+func (l layered) Add(ctx context.Context, key string, value interface{}) {
+	if _, ok := l.manifest[key]; !ok {
+		l.cache.Add(ctx, key, value)
+	}
+}
+
+func (l layered) store(ctx context.Context, key string, query string) {
+	if _, ok := l.manifest[key]; ok {
+		return
+	}
+	if store, ok := l.cache.(validatedStore); ok {
+		store.store(ctx, key, query)
+	}
+}
@@ -0,0 +1,108 @@
+package persisted
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// PostgresCache keeps documents in a table shared by every instance, so a document registered
+// through one instance can be sent by hash to any other. The table needs the columns
+//
+//	hash text primary key, query text not null
+//
+// Add only holds a document in memory. It is written to the table by StoreValidated once its
+// operation has passed validation and the limits, and only while the table has fewer than
+// maxRows documents, so anonymous clients can't fill it with junk. Failures are logged and
+// treated as misses, which only costs the client a retry with the full document.
+type PostgresCache struct {
+	db     *pgxpool.Pool
+	get    string
+	insert string
+	recent *lru.LRU
+}
+
+var _ graphql.Cache = &PostgresCache{}
+
+func NewPostgresCache(db *pgxpool.Pool, table string, size int, maxRows int) *PostgresCache {
+	return &PostgresCache{
+		db:  db,
+		get: "SELECT query FROM " + table + " WHERE hash = $1",
+		insert: "INSERT INTO " + table + " (hash, query) SELECT $1, $2 " +
+			"WHERE (SELECT count(*) FROM " + table + ") < " + strconv.Itoa(maxRows) + " ON CONFLICT (hash) DO NOTHING",
+		recent: lru.New(size),
+	}
+}
+
+func (c *PostgresCache) Get(ctx context.Context, key string) (interface{}, bool) {
+	if query, ok := c.recent.Get(ctx, key); ok {
+		return query, true
+	}
+
+	var query string
+	err := c.db.QueryRow(ctx, c.get, key).Scan(&query)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, false
+	} else if err != nil {
+		slog.WarnContext(ctx, "reading persisted query", "error", err)
+		return nil, false
+	}
+	c.recent.Add(ctx, key, query)
+	return query, true
+}
+
+func (c *PostgresCache) Add(ctx context.Context, key string, value interface{}) {
+	if query, ok := value.(string); ok {
+		c.recent.Add(ctx, key, query)
+	}
+}
+
+func (c *PostgresCache) store(ctx context.Context, key string, query string) {
+	if _, err := c.db.Exec(ctx, c.insert, key, query); err != nil {
+		slog.WarnContext(ctx, "storing persisted query", "error", err)
+	}
+}
+
+// Caches that keep documents for good once their operation is known to be valid
+type validatedStore interface {
+	store(ctx context.Context, key string, query string)
+}
+
+// StoreValidated writes a document registered through AutomaticPersistedQuery to Cache once
+// its operation has been validated. Caches that keep everything in memory ignore it. It must be
+// added after every extension that can reject an operation.
+type StoreValidated struct {
+	Cache graphql.Cache
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = StoreValidated{}
+
+func (StoreValidated) ExtensionName() string {
+	return "StoreValidated"
+}
+
+func (StoreValidated) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (s StoreValidated) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	store, ok := s.Cache.(validatedStore)
+	if !ok {
+		return nil
+	}
+	if stats := extension.GetApqStats(ctx); stats != nil && stats.SentQuery {
+		store.store(ctx, stats.Hash, rc.RawQuery)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Extension charges each operation's complexity to the client's allowance. It must be added
+// after the ComplexityLimit extension, whose result it reads.
+type Extension struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = Extension{}
+
+func (Extension) ExtensionName() string {
+	return "RateLimit"
+}
+
+func (Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Extension) MutateOperationContext(ctx context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	state, ok := ctx.Value(contextKey{}).(*requestState)
+	if !ok {
+		return nil
+	}
+	stats, ok := rc.Stats.GetExtension("ComplexityLimit").(*extension.ComplexityStats)
+	if !ok {
+		return nil
+	}
+
+	usage, ok := state.limiter.take(state.id, state.tier, 0, stats.Complexity)
+	state.mu.Lock()
+	defer state.mu.Unlock()
+	state.usage = usage
+	if ok {
+		return nil
+	}
+
+	state.limited = true
+	var err *gqlerror.Error
+	if usage.retryAfter == math.MaxInt64 {
+		err = gqlerror.Errorf("operation has complexity %d, which exceeds your allowance of %d per minute", stats.Complexity, state.tier.ComplexityPerMinute)
+	} else {
+		err = gqlerror.Errorf("operation has complexity %d but only %d remains, retry in %ss", stats.Complexity, usage.complexityRemaining, retryAfter(usage.retryAfter))
+	}
+	errcode.Set(err, errRateLimited)
+	return err
+}
@@ -0,0 +1,178 @@
+package ratelimit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/owenjacob/hubblegraphql/clientip"
+)
+
+const (
+	errInvalidKey    = "INVALID_API_KEY"
+	errKeyRequired   = "API_KEY_REQUIRED"
+	errKeyNotAllowed = "API_KEY_NOT_ALLOWED"
+	errRateLimited   = "RATE_LIMITED"
+)
+
+type contextKey struct{}
+
+// What the middleware knows about the client, which Extension needs to charge complexity
+type requestState struct {
+	limiter *Limiter
+	id      string
+	tier    Tier
+
+	mu      sync.Mutex
+	usage   usage
+	limited bool
+}
+
+// Middleware authenticates the API key sent in the X-API-Key header or the api_key query
+// parameter and charges one request to the client. Rejected requests never reach next.
+func Middleware(l *Limiter, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		apiKey := req.Header.Get("X-API-Key")
+		if apiKey == "" {
+			apiKey = req.URL.Query().Get("api_key")
+		}
+
+		address := clientip.FromRequest(req)
+		if apiKey != "" {
+			// Made up keys would otherwise skip rate limiting and cost a lookup each, so a
+			// client that keeps getting its key wrong is refused before the lookup
+			if wait := l.invalidKeyWait(address); wait > 0 {
+				w.Header().Set("Retry-After", retryAfter(wait))
+				writeError(w, http.StatusTooManyRequests, "Too many invalid API keys, retry in "+retryAfter(wait)+"s", errRateLimited)
+				return
+			}
+		}
+
+		id, tier, err := l.identify(req.Context(), apiKey, address)
+		if errors.Is(err, ErrUnknownKey) {
+			l.chargeInvalidKey(address)
+			writeError(w, http.StatusUnauthorized, "Invalid API key", errInvalidKey)
+			return
+		}
+		if err != nil {
+			slog.ErrorContext(req.Context(), "looking up API key", "error", err)
+			writeError(w, http.StatusServiceUnavailable, "API keys are unavailable", "INTERNAL_SERVER_ERROR")
+			return
+		}
+		if tier.RequestsPerMinute <= 0 {
+			if apiKey != "" {
+				writeError(w, http.StatusForbidden, "API key is not allowed to make requests", errKeyNotAllowed)
+			} else {
+				writeError(w, http.StatusUnauthorized, "An API key is required", errKeyRequired)
+			}
+			return
+		}
+
+		usage, ok := l.take(id, tier, 1, 0)
+		if !ok {
+			setHeaders(w.Header(), usage, true)
+			writeError(w, http.StatusTooManyRequests, "Too many requests, retry in "+retryAfter(usage.retryAfter)+"s", errRateLimited)
+			return
+		}
+
+		state := &requestState{limiter: l, id: id, tier: tier, usage: usage}
+		next.ServeHTTP(&limitedWriter{ResponseWriter: w, state: state}, req.WithContext(context.WithValue(req.Context(), contextKey{}, state)))
+	})
+}
+
+// The complexity of an operation is only known once gqlgen has parsed it, after the middleware
+// has handed over. limitedWriter adds the final usage headers and turns the error status of an
+// operation rejected by Extension into a 429.
+type limitedWriter struct {
+	http.ResponseWriter
+	state       *requestState
+	wroteHeader bool
+}
+
+func (w *limitedWriter) WriteHeader(status int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.state.mu.Lock()
+		// A response that shared caches may store is served to other clients too, so it can't
+		// carry this client's usage
+		if !strings.HasPrefix(w.Header().Get("Cache-Control"), "public") {
+			setHeaders(w.Header(), w.state.usage, w.state.limited)
+		}
+		if w.state.limited {
+			status = http.StatusTooManyRequests
+		}
+		w.state.mu.Unlock()
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *limitedWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *limitedWriter) Flush() {
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (w *limitedWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	return hijacker.Hijack()
+}
+
+func (w *limitedWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func setHeaders(h http.Header, u usage, limited bool) {
+	h.Set("X-RateLimit-Limit", strconv.Itoa(u.tier.RequestsPerMinute))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(u.requestsRemaining))
+	h.Set("X-RateLimit-Reset", strconv.Itoa(seconds(u.requestsReset)))
+	h.Set("X-RateLimit-Complexity-Limit", strconv.Itoa(u.tier.ComplexityPerMinute))
+	h.Set("X-RateLimit-Complexity-Remaining", strconv.Itoa(u.complexityRemaining))
+	h.Set("X-RateLimit-Complexity-Reset", strconv.Itoa(seconds(u.complexityReset)))
+	// An operation costing more than a whole minute's allowance can't succeed by waiting
+	if limited && u.retryAfter != math.MaxInt64 {
+		h.Set("Retry-After", retryAfter(u.retryAfter))
+	}
+}
+
+func retryAfter(d time.Duration) string {
+	return strconv.Itoa(seconds(d))
+}
+
+// Rounded up so that a client waiting the advertised time always finds capacity
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// Errors are shaped like GraphQL responses so clients handle them the same way
+func writeError(w http.ResponseWriter, status int, message string, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"errors": []interface{}{
+			map[string]interface{}{
+				"message":    message,
+				"extensions": map[string]string{"code": code},
+			},
+		},
+		"data": nil,
+	})
+}
@@ -0,0 +1,174 @@
+// Package ratelimit identifies clients by API key and limits how many requests and how many
+// complexity points each of them can spend per minute. Clients without a key share the
+// anonymous tier, bucketed by address.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Allowance per minute. A zero RequestsPerMinute blocks the tier entirely.
+type Tier struct {
+	RequestsPerMinute   int `yaml:"requestsPerMinute"`
+	ComplexityPerMinute int `yaml:"complexityPerMinute"`
+}
+
+// An API key as held by a Store. Only the SHA-256 of the key is stored, never the key itself.
+type Key struct {
+	Name    string
+	KeyHash string
+	Tier    Tier
+}
+
+// Buckets untouched for this long are full again and can be forgotten
+const idleAfter = time.Minute
+
+type Limiter struct {
+	store     Store
+	anonymous Tier
+	now       func() time.Time
+
+	mu        sync.Mutex
+	clients   map[string]*client
+	lastSweep time.Time
+}
+
+type client struct {
+	requests   bucket
+	complexity bucket
+}
+
+func New(store Store, anonymous Tier) *Limiter {
+	return &Limiter{
+		store:     store,
+		anonymous: anonymous,
+		now:       time.Now,
+		clients:   map[string]*client{},
+	}
+}
+
+// Resolves the API key, or the anonymous tier when apiKey is empty. Unknown keys return ErrUnknownKey.
+func (l *Limiter) identify(ctx context.Context, apiKey string, address string) (string, Tier, error) {
+	if apiKey == "" {
+		return "anonymous:" + address, l.anonymous, nil
+	}
+	key, err := l.store.Lookup(ctx, hashKey(apiKey))
+	if err != nil {
+		return "", Tier{}, err
+	}
+	return "key:" + key.Name, key.Tier, nil
+}
+
+// Unknown API keys each client address may send per minute
+const invalidKeysPerMinute = 30
+
+var invalidKeyTier = Tier{RequestsPerMinute: invalidKeysPerMinute}
+
+// How long address has to wait before its next unknown key is looked up, 0 when it needn't
+func (l *Limiter) invalidKeyWait(address string) time.Duration {
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	c, ok := l.clients["invalid:"+address]
+	if !ok {
+		return 0
+	}
+	c.requests.refill(invalidKeysPerMinute, now)
+	return c.requests.wait(invalidKeysPerMinute, 1)
+}
+
+func (l *Limiter) chargeInvalidKey(address string) {
+	l.take("invalid:"+address, invalidKeyTier, 1, 0)
+}
+
+// Takes cost from the named bucket pair. When it doesn't fit nothing is taken and the wait
+// until it would fit is returned.
+func (l *Limiter) take(id string, tier Tier, requests, complexity int) (usage, bool) {
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > idleAfter {
+		for name, c := range l.clients {
+			if now.Sub(c.requests.updated) > idleAfter && now.Sub(c.complexity.updated) > idleAfter {
+				delete(l.clients, name)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	c, ok := l.clients[id]
+	if !ok {
+		c = &client{
+			requests:   newBucket(tier.RequestsPerMinute, now),
+			complexity: newBucket(tier.ComplexityPerMinute, now),
+		}
+		l.clients[id] = c
+	}
+	c.requests.refill(tier.RequestsPerMinute, now)
+	c.complexity.refill(tier.ComplexityPerMinute, now)
+
+	wait := c.requests.wait(tier.RequestsPerMinute, requests)
+	if complexityWait := c.complexity.wait(tier.ComplexityPerMinute, complexity); complexityWait > wait {
+		wait = complexityWait
+	}
+	if wait == 0 {
+		c.requests.tokens -= float64(requests)
+		c.complexity.tokens -= float64(complexity)
+	}
+	return usage{
+		tier:                tier,
+		requestsRemaining:   int(c.requests.tokens),
+		requestsReset:       c.requests.untilFull(tier.RequestsPerMinute),
+		complexityRemaining: int(c.complexity.tokens),
+		complexityReset:     c.complexity.untilFull(tier.ComplexityPerMinute),
+		retryAfter:          wait,
+	}, wait == 0
+}
+
+// Snapshot of a client's buckets, reported in the X-RateLimit headers
+type usage struct {
+	tier                Tier
+	requestsRemaining   int
+	requestsReset       time.Duration
+	complexityRemaining int
+	complexityReset     time.Duration
+	retryAfter          time.Duration
+}
+
+// Token bucket holding up to a minute's allowance and refilling continuously
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+func newBucket(perMinute int, now time.Time) bucket {
+	return bucket{tokens: float64(perMinute), updated: now}
+}
+
+func (b *bucket) refill(perMinute int, now time.Time) {
+	b.tokens = math.Min(float64(perMinute), b.tokens+now.Sub(b.updated).Minutes()*float64(perMinute))
+	b.updated = now
+}
+
+// A cost larger than the whole allowance can never be paid, which is reported as waiting forever
+func (b *bucket) wait(perMinute int, cost int) time.Duration {
+	if float64(cost) <= b.tokens {
+		return 0
+	}
+	if cost > perMinute {
+		return math.MaxInt64
+	}
+	return time.Duration((float64(cost) - b.tokens) / float64(perMinute) * float64(time.Minute))
+}
+
+func (b *bucket) untilFull(perMinute int) time.Duration {
+	if perMinute == 0 {
+		return 0
+	}
+	return time.Duration((float64(perMinute) - b.tokens) / float64(perMinute) * float64(time.Minute))
+}
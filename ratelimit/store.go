@@ -0,0 +1,186 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"gopkg.in/yaml.v2"
+)
+
+var ErrUnknownKey = errors.New("unknown API key")
+
+// Store looks keys up by the hex SHA-256 of the key
+type Store interface {
+	Lookup(ctx context.Context, keyHash string) (*Key, error)
+}
+
+func hashKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// Used when no key source is configured, so every key is rejected
+type NoKeys struct{}
+
+func (NoKeys) Lookup(ctx context.Context, keyHash string) (*Key, error) {
+	return nil, ErrUnknownKey
+}
+
+// Entry in a keys file. Tier values left at zero fall back to the defaults given to LoadFile.
+type fileKey struct {
+	Name    string `yaml:"name"`
+	KeyHash string `yaml:"keyHash"`
+	Tier    `yaml:",inline"`
+}
+
+type FileStore struct {
+	keys map[string]*Key
+}
+
+// LoadFile reads a YAML list of keys, for example
+//
+//   - name: explorer
+//     keyHash: 2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae
+//     requestsPerMinute: 1200
+//
+// where keyHash is the output of `printf %s "$KEY" | sha256sum`.
+func LoadFile(path string, defaults Tier) (*FileStore, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading API keys: %v", err)
+	}
+	var entries []fileKey
+	if err := yaml.UnmarshalStrict(contents, &entries); err != nil {
+		return nil, fmt.Errorf("parsing API keys %s: %v", path, err)
+	}
+
+	store := &FileStore{keys: map[string]*Key{}}
+	for i, entry := range entries {
+		if entry.Name == "" {
+			return nil, fmt.Errorf("API key %d has no name", i+1)
+		}
+		keyHash := strings.ToLower(entry.KeyHash)
+		if decoded, err := hex.DecodeString(keyHash); err != nil || len(decoded) != sha256.Size {
+			return nil, fmt.Errorf("API key %s: keyHash must be a hex SHA-256", entry.Name)
+		}
+		if _, ok := store.keys[keyHash]; ok {
+			return nil, fmt.Errorf("API key %s: duplicate keyHash", entry.Name)
+		}
+		store.keys[keyHash] = &Key{Name: entry.Name, KeyHash: keyHash, Tier: withDefaults(entry.Tier, defaults)}
+	}
+	return store, nil
+}
+
+func (s *FileStore) Lookup(ctx context.Context, keyHash string) (*Key, error) {
+	key, ok := s.keys[keyHash]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// Row of the keys table. Disabled keys are treated as unknown.
+type apiKey struct {
+	Name                string
+	RequestsPerMinute   *int
+	ComplexityPerMinute *int
+	Disabled            bool
+}
+
+// Lookups are cached briefly so that a busy client doesn't cost a query per request, and
+// so that revoking a key takes effect within the same interval
+const keyCacheTTL = time.Minute
+
+// Bounds the cache when clients send many different keys
+const keyCacheSize = 10000
+
+type cachedKey struct {
+	key     *Key
+	expires time.Time
+}
+
+// PostgresStore reads keys from a table with the columns
+//
+//	name text, key_hash text primary key, requests_per_minute int,
+//	complexity_per_minute int, disabled boolean
+//
+// where null tier columns fall back to the defaults.
+type PostgresStore struct {
+	db       *pgxpool.Pool
+	query    string
+	defaults Tier
+
+	mu    sync.Mutex
+	cache map[string]cachedKey
+}
+
+func NewPostgresStore(db *pgxpool.Pool, table string, defaults Tier) *PostgresStore {
+	return &PostgresStore{
+		db:       db,
+		query:    "SELECT name, requests_per_minute, complexity_per_minute, disabled FROM " + table + " WHERE key_hash = $1",
+		defaults: defaults,
+		cache:    map[string]cachedKey{},
+	}
+}
+
+func (s *PostgresStore) Lookup(ctx context.Context, keyHash string) (*Key, error) {
+	now := time.Now()
+	s.mu.Lock()
+	cached, ok := s.cache[keyHash]
+	s.mu.Unlock()
+	if ok && now.Before(cached.expires) {
+		if cached.key == nil {
+			return nil, ErrUnknownKey
+		}
+		return cached.key, nil
+	}
+
+	var row apiKey
+	var key *Key
+	err := s.db.QueryRow(ctx, s.query, keyHash).Scan(&row.Name, &row.RequestsPerMinute, &row.ComplexityPerMinute, &row.Disabled)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return nil, err
+	case !row.Disabled:
+		tier := Tier{}
+		if row.RequestsPerMinute != nil {
+			tier.RequestsPerMinute = *row.RequestsPerMinute
+		}
+		if row.ComplexityPerMinute != nil {
+			tier.ComplexityPerMinute = *row.ComplexityPerMinute
+		}
+		key = &Key{Name: row.Name, KeyHash: keyHash, Tier: withDefaults(tier, s.defaults)}
+	}
+
+	s.mu.Lock()
+	if len(s.cache) >= keyCacheSize {
+		s.cache = map[string]cachedKey{}
+	}
+	s.cache[keyHash] = cachedKey{key: key, expires: now.Add(keyCacheTTL)}
+	s.mu.Unlock()
+
+	if key == nil {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+func withDefaults(tier Tier, defaults Tier) Tier {
+	if tier.RequestsPerMinute <= 0 {
+		tier.RequestsPerMinute = defaults.RequestsPerMinute
+	}
+	if tier.ComplexityPerMinute <= 0 {
+		tier.ComplexityPerMinute = defaults.ComplexityPerMinute
+	}
+	return tier
+}
@@ -1,16 +1,40 @@
 package main
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"log/slog"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
-	"github.com/jinzhu/gorm"
+	"github.com/jackc/pgx/v5/multitracer"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/julienschmidt/httprouter"
+	"github.com/owenjacob/hubblegraphql/apierror"
+	"github.com/owenjacob/hubblegraphql/clientip"
+	"github.com/owenjacob/hubblegraphql/config"
+	"github.com/owenjacob/hubblegraphql/database"
+	"github.com/owenjacob/hubblegraphql/entitycache"
 	"github.com/owenjacob/hubblegraphql/graph"
 	"github.com/owenjacob/hubblegraphql/graph/generated"
+	"github.com/owenjacob/hubblegraphql/httpcache"
+	"github.com/owenjacob/hubblegraphql/logging"
+	"github.com/owenjacob/hubblegraphql/metrics"
+	"github.com/owenjacob/hubblegraphql/persisted"
+	"github.com/owenjacob/hubblegraphql/ratelimit"
+	"github.com/owenjacob/hubblegraphql/tracing"
 	"github.com/rs/cors"
 )
 
@@ -23,35 +47,317 @@ func playgroundHandler() httprouter.Handle {
 	}
 }
 
+// Everything the GraphQL handler needs besides its stores
+type handlerOptions struct {
+	limits  graph.Limits
+	timeout graph.Timeout
+	// max-age of cacheable GET responses
+	cacheMaxAge time.Duration
+	// Nil when rate limiting is off
+	limiter *ratelimit.Limiter
+	// Documents sent by clients as automatic persisted queries
+	persistedQueries graphql.Cache
+	// When set only documents in the manifest can run
+	allowList persisted.Manifest
+	// Operation names reported in metrics
+	operationNames map[string]bool
+}
+
 // Defining the Graphql handler
-func graphqlHandler(dbConnection *gorm.DB) httprouter.Handle {
-	h := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: &graph.Resolver{DB: dbConnection}}))
+func graphqlHandler(stores graph.Stores, opts handlerOptions) httprouter.Handle {
+	h := handler.New(generated.NewExecutableSchema(generated.Config{
+		Resolvers:  &graph.Resolver{Stores: stores, Limits: opts.limits},
+		Complexity: graph.Complexity(opts.limits),
+	}))
+	h.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+	})
+	h.AddTransport(transport.Options{})
+	h.AddTransport(transport.GET{})
+	h.AddTransport(transport.POST{})
+	h.AddTransport(transport.MultipartForm{})
+	h.SetQueryCache(lru.New(1000))
+	h.SetErrorPresenter(apierror.Presenter)
+	h.SetRecoverFunc(apierror.Recover)
+
+	h.Use(extension.Introspection{})
+	h.Use(extension.AutomaticPersistedQuery{Cache: opts.persistedQueries})
+	if opts.allowList != nil {
+		h.Use(persisted.AllowList{Manifest: opts.allowList})
+	}
+	h.Use(graph.DepthLimit{Limit: opts.limits.MaxQueryDepth})
+	h.Use(extension.FixedComplexityLimit(opts.limits.MaxQueryComplexity))
+	h.Use(metrics.Extension{Operations: opts.operationNames})
+	h.Use(tracing.Extension{})
+	h.Use(logging.Extension{})
+	h.Use(opts.timeout)
+	h.Use(httpcache.Extension{Finalized: graph.Finalized})
+
+	next := httpcache.Middleware(opts.cacheMaxAge, h)
+	if opts.limiter != nil {
+		h.Use(ratelimit.Extension{})
+		next = ratelimit.Middleware(opts.limiter, next)
+	}
+	// Last, so that only documents every other extension accepted are kept
+	h.Use(persisted.StoreValidated{Cache: opts.persistedQueries})
 
 	return func(w http.ResponseWriter, req *http.Request, ps httprouter.Params) {
-		h.ServeHTTP(w, req)
+		next.ServeHTTP(w, req)
 	}
 }
 
+// The manifest is validated against the schema, so a stale operation stops the server starting.
+// It is returned as well as the cache, nil when there is none.
+func persistedQueries(cfg config.PersistedQueries, dbConnection *pgxpool.Pool) (graphql.Cache, persisted.Manifest, error) {
+	var cache graphql.Cache = lru.New(cfg.CacheSize)
+	if cfg.Cache == "postgres" {
+		cache = persisted.NewPostgresCache(dbConnection, cfg.Table, cfg.CacheSize, cfg.TableMaxRows)
+	}
+	if cfg.ManifestDir == "" {
+		return cache, nil, nil
+	}
+
+	manifest, err := persisted.LoadManifest(cfg.ManifestDir, generated.NewExecutableSchema(generated.Config{}).Schema())
+	if err != nil {
+		return nil, nil, err
+	}
+	if cfg.Strict {
+		return manifest, manifest, nil
+	}
+	return persisted.WithManifest(manifest, cache), manifest, nil
+}
+
+// Keys come from a file, a table or nowhere, in which case only anonymous access is possible
+func newLimiter(cfg config.RateLimit, dbConnection *pgxpool.Pool) (*ratelimit.Limiter, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	defaults := ratelimit.Tier(cfg.Keys)
+	var store ratelimit.Store = ratelimit.NoKeys{}
+	switch {
+	case cfg.KeysFile != "":
+		keys, err := ratelimit.LoadFile(cfg.KeysFile, defaults)
+		if err != nil {
+			return nil, err
+		}
+		store = keys
+	case cfg.KeysTable != "":
+		store = ratelimit.NewPostgresStore(dbConnection, cfg.KeysTable, defaults)
+	}
+	return ratelimit.New(store, ratelimit.Tier(cfg.Anonymous)), nil
+}
+
+// Opens a connection pool with the configured limits, logging, metrics and tracing. name
+// labels its pool statistics.
+func openDB(ctx context.Context, dsn string, name string, cfg *config.Config, logger *slog.Logger) (*pgxpool.Pool, error) {
+	db, err := database.Open(ctx, dsn, database.Options{
+		MaxConns:        cfg.DB.MaxOpenConns,
+		MinConns:        cfg.DB.MinConns,
+		MaxConnLifetime: cfg.DB.ConnMaxLifetime,
+		Tracer: multitracer.New(
+			metrics.QueryTracer{},
+			tracing.QueryTracer{},
+			logging.SQLTracer{Logger: logger, SampleRate: cfg.Log.SQLSampleRate},
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+	metrics.RegisterPool(db, name)
+	return db, nil
+}
+
 // Implementation with Httprouter from: https://qiita.com/tlta-bkhn/items/3f8883a7db059aa58717
 func main() {
-	dbConnection, err := gorm.Open("postgres", "host="+os.Getenv("DB_HOST")+" port=5432 user="+os.Getenv("DB_USERNAME")+" dbname="+os.Getenv("DB_DATABASE")+" password="+os.Getenv("DB_PASSWORD")+" sslmode=disable")
+	// "hubblegraphql healthcheck" reads the same config as the server and exits non-zero unless it
+	// is answering
+	args, check := os.Args[1:], false
+	if len(args) > 0 && args[0] == "healthcheck" {
+		args, check = args[1:], true
+	}
+
+	cfg, err := config.Load(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if check {
+		if err := healthcheck(cfg.ListenAddr); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	logger := logging.New(os.Stdout, cfg.Log.Level)
+	slog.SetDefault(logger)
+
+	if err := run(cfg, logger); err != nil {
+		logger.Error("server stopped", "error", err)
+		os.Exit(1)
+	}
+}
+
+// Serves until a shutdown signal arrives or the server fails. Whatever was opened is closed
+// and buffered spans are flushed before it returns, so main can exit either way.
+func run(cfg *config.Config, logger *slog.Logger) error {
+	shutdownTracing, err := tracing.Setup(context.Background(), tracing.Config{
+		Enabled:     cfg.Tracing.Enabled,
+		Endpoint:    cfg.Tracing.Endpoint,
+		Insecure:    cfg.Tracing.Insecure,
+		SampleRatio: cfg.Tracing.SampleRatio,
+		ServiceName: cfg.Tracing.ServiceName,
+	})
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("setting up tracing: %w", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Warn("flushing traces", "error", err)
+		}
+	}()
+
+	dbConnection, err := openDB(context.Background(), cfg.DB.ConnectionString(), "horizon", cfg, logger)
+	if err != nil {
+		return fmt.Errorf("connecting to database: %w", err)
 	}
-	dbConnection.LogMode(true)
 	defer dbConnection.Close()
+	var replicas *database.Replicas
+	if len(cfg.DB.Replicas) > 0 {
+		replicaConnections := map[string]*pgxpool.Pool{}
+		for i, dsn := range cfg.DB.ReplicaConnectionStrings() {
+			name := "horizon_replica_" + strconv.Itoa(i+1)
+			replicaConnections[name], err = openDB(context.Background(), dsn, name, cfg, logger)
+			if err != nil {
+				return fmt.Errorf("connecting to replica %s: %w", name, err)
+			}
+			defer replicaConnections[name].Close()
+		}
+		replicas = database.NewReplicas(dbConnection, replicaConnections, cfg.DB.MaxReplicaLag)
+		replicas.Check(context.Background())
+	}
+
+	proxies, err := clientip.ParseProxies(cfg.TrustedProxies)
+	if err != nil {
+		return err
+	}
 
 	mux := httprouter.New()
 
 	c := cors.New(cors.Options{
-		AllowedOrigins: []string{"*"},
+		AllowedOrigins: cfg.CORS.AllowedOrigins,
 		AllowedHeaders: []string{"*"},
 		AllowedMethods: []string{"GET", "POST", "OPTIONS"},
+		ExposedHeaders: []string{
+			"ETag", "Retry-After",
+			"X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset",
+			"X-RateLimit-Complexity-Limit", "X-RateLimit-Complexity-Remaining", "X-RateLimit-Complexity-Reset",
+		},
 	})
 
+	limits := graph.Limits{
+		MaxSearchLimit:     cfg.Limits.MaxSearchLimit,
+		MaxTxnsLimit:       cfg.Limits.MaxTxnsLimit,
+		MaxOpsLimit:        cfg.Limits.MaxOpsLimit,
+		MaxPathHops:        cfg.Limits.MaxPathHops,
+		MaxOrderBookOffers: cfg.Limits.MaxOrderBookOffers,
+		MaxPathExpansions:  cfg.Limits.MaxPathExpansions,
+		MaxFeeStatsLedgers: cfg.Limits.MaxFeeStatsLedgers,
+		MaxQueryDepth:      cfg.Limits.MaxQueryDepth,
+		MaxQueryComplexity: cfg.Limits.MaxQueryComplexity,
+	}
+
+	limiter, err := newLimiter(cfg.RateLimit, dbConnection)
+	if err != nil {
+		return fmt.Errorf("loading API keys: %w", err)
+	}
+
 	mux.GET("/", playgroundHandler())
-	mux.POST("/query", graphqlHandler(dbConnection))
+	queryCache, manifest, err := persistedQueries(cfg.PersistedQueries, dbConnection)
+	if err != nil {
+		return fmt.Errorf("setting up persisted queries: %w", err)
+	}
+	var allowList persisted.Manifest
+	if cfg.PersistedQueries.Strict {
+		allowList = manifest
+	}
+	operationNames := map[string]bool{}
+	for _, name := range append(manifest.OperationNames(), cfg.Metrics.OperationNames...) {
+		operationNames[name] = true
+	}
+
+	postgres := &graph.PostgresStore{DB: dbConnection, Replicas: replicas}
+	stores := graph.WithCache(graph.NewPostgresStores(dbConnection, replicas), entitycache.New(int64(cfg.EntityCache.MaxMemoryMB)<<20))
+	query := graphqlHandler(stores, handlerOptions{
+		limits:           limits,
+		timeout:          graph.Timeout{Default: cfg.Timeouts.Request, Overrides: cfg.Timeouts.Overrides},
+		cacheMaxAge:      cfg.HTTPCache.MaxAge,
+		limiter:          limiter,
+		persistedQueries: queryCache,
+		allowList:        allowList,
+		operationNames:   operationNames,
+	})
+	mux.GET("/query", query)
+	mux.POST("/query", query)
+	mux.GET("/export/transactions", exportTransactionsHandler(postgres, cfg.Limits.MaxExportLedgers, cfg.Timeouts.Export, limiter))
+	mux.GET("/healthz", healthzHandler())
+	mux.GET("/readyz", readyzHandler(dbConnection, cfg.Health.MaxIngestionLag))
+	mux.GET("/status", statusHandler(dbConnection))
+
+	// Every request context derives from baseCtx so that work still running when the drain
+	// deadline passes can be cancelled before the database is closed underneath it
+	baseCtx, cancelRequests := context.WithCancel(context.Background())
+	defer cancelRequests()
+	if replicas != nil {
+		go replicas.Watch(baseCtx, cfg.DB.ReplicaCheckInterval)
+	}
+
+	websockets := &connectionTracker{}
+	server := &http.Server{
+		Addr:        cfg.ListenAddr,
+		Handler:     websockets.Handler(tracing.Handler(clientip.Middleware(proxies, logging.Middleware(logger, c.Handler(mux))))),
+		BaseContext: func(net.Listener) context.Context { return baseCtx },
+		ErrorLog:    slog.NewLogLogger(logger.Handler(), slog.LevelWarn),
+	}
+
+	// Metrics have a listener of their own that isn't exposed with the API
+	metricsMux := http.NewServeMux()
+	metricsMux.Handle("/metrics", metrics.Handler())
+	metricsServer := &http.Server{
+		Addr:     cfg.Metrics.ListenAddr,
+		Handler:  metricsMux,
+		ErrorLog: slog.NewLogLogger(logger.Handler(), slog.LevelWarn),
+	}
+
+	serverErr := make(chan error, 2)
+	go func() {
+		serverErr <- server.ListenAndServe()
+	}()
+	go func() {
+		serverErr <- metricsServer.ListenAndServe()
+	}()
+	logger.Info("listening", "addr", cfg.ListenAddr, "metrics_addr", cfg.Metrics.ListenAddr, "version", version)
 
-	router := c.Handler(mux)
-	log.Fatal(http.ListenAndServe(":3000", router))
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	select {
+	case err := <-serverErr:
+		return fmt.Errorf("serving: %w", err)
+	case sig := <-stop:
+		logger.Info("draining connections", "signal", sig.String(), "timeout", cfg.ShutdownTimeout.String())
+	}
+
+	// Shutdown stops accepting connections and waits for in-flight queries, while websocket
+	// subscriptions are left to finish on their own until the same deadline
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(ctx); err != nil {
+		logger.Warn("queries still running at shutdown deadline", "error", err)
+	}
+	if err := websockets.Wait(ctx); err != nil {
+		logger.Warn("subscriptions still open at shutdown deadline", "error", err)
+	}
+	metricsServer.Shutdown(ctx)
+	return nil
 }
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// Keeps count of websocket connections. They are hijacked from the http.Server, so its
+// Shutdown stops tracking them and would otherwise cut subscriptions off mid stream.
+type connectionTracker struct {
+	wg sync.WaitGroup
+}
+
+func (t *connectionTracker) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if strings.EqualFold(req.Header.Get("Upgrade"), "websocket") {
+			t.wg.Add(1)
+			defer t.wg.Done()
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// Blocks until every tracked connection has closed or ctx is done
+func (t *connectionTracker) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		t.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,77 @@
+// Package tracing sets up OpenTelemetry spans for each HTTP request, GraphQL resolver and the
+// SQL queries they run. Tracing is off unless enabled in the config, in which case spans are
+// exported over OTLP/HTTP.
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/owenjacob/hubblegraphql/tracing"
+
+type Config struct {
+	Enabled     bool
+	Endpoint    string
+	Insecure    bool
+	SampleRatio float64
+	ServiceName string
+}
+
+// Setup installs the global tracer provider and W3C propagators. The returned function flushes
+// any buffered spans and should be called before the process exits.
+func Setup(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+	if cfg.Insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := NewProvider(sdktrace.NewBatchSpanProcessor(exporter), cfg.ServiceName, cfg.SampleRatio)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// NewProvider builds a tracer provider around processor. Tests can pass a syncer wrapping
+// tracetest.NewInMemoryExporter to inspect the spans produced.
+func NewProvider(processor sdktrace.SpanProcessor, serviceName string, sampleRatio float64) *sdktrace.TracerProvider {
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+}
+
+// Handler starts a span for each GraphQL request, continuing any trace context sent by the
+// client. Probes and metric scrapes are left untraced.
+func Handler(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(next, "graphql",
+		otelhttp.WithFilter(func(req *http.Request) bool {
+			return req.URL.Path == "/query"
+		}),
+		otelhttp.WithSpanNameFormatter(func(operation string, req *http.Request) string {
+			return req.Method + " " + req.URL.Path
+		}),
+	)
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}